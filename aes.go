@@ -6,6 +6,8 @@ import (
 	"crypto/cipher"
 	"crypto/rand"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
 
 	"github.com/pkg/errors"
 )
@@ -92,9 +94,62 @@ func pKCS5Padding(ciphertext []byte, blockSize int) []byte {
 
 func pKCS5UnPadding(origData []byte) ([]byte, error) {
 	length := len(origData)
+	if length == 0 {
+		return nil, errors.New("invalid data")
+	}
 	unpadding := int(origData[length-1])
-	if unpadding > length {
+	if unpadding == 0 || unpadding > length || unpadding > aes.BlockSize {
+		return nil, errors.New("invalid data")
+	}
+	//校验所有填充字节
+	padtext := bytes.Repeat([]byte{byte(unpadding)}, unpadding)
+	if subtle.ConstantTimeCompare(origData[length-unpadding:], padtext) != 1 {
 		return nil, errors.New("invalid data")
 	}
 	return origData[:(length - unpadding)], nil
 }
+
+// AesGCMEncrypt encrypts text with AES-256-GCM, key is sha256 of rawKey.
+// additionalData is authenticated but not encrypted, the same data must be
+// passed to AesGCMDecrypt.
+// Output format: nonce(12 bytes)+ciphertext+tag(16 bytes)
+func AesGCMEncrypt(text, rawKey, additionalData []byte) (ciphertext []byte, err error) {
+	aead, err := newGCM(rawKey)
+	if err != nil {
+		return
+	}
+
+	nonce, err := randomBytes(aead.NonceSize())
+	if err != nil {
+		return
+	}
+
+	return aead.Seal(nonce, nonce, text, additionalData), nil
+}
+
+// AesGCMDecrypt decrypts ciphertext generated by AesGCMEncrypt
+func AesGCMDecrypt(ciphertext, rawKey, additionalData []byte) (text []byte, err error) {
+	aead, err := newGCM(rawKey)
+	if err != nil {
+		return
+	}
+
+	ns := aead.NonceSize()
+	if len(ciphertext) < ns+aead.Overhead() {
+		err = errors.New("ciphertext too short")
+		return
+	}
+
+	return aead.Open(nil, ciphertext[:ns], ciphertext[ns:], additionalData)
+}
+
+func newGCM(rawKey []byte) (cipher.AEAD, error) {
+	key := sha256.Sum256(rawKey)
+
+	cipherBlock, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(cipherBlock)
+}
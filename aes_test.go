@@ -45,6 +45,50 @@ func TestAesEncrypt(t *testing.T) {
 	}
 }
 
+func TestPKCS5UnPadding(t *testing.T) {
+	ast := assert.New(t)
+
+	text, err := pKCS5UnPadding([]byte{'a', 'b', 3, 3, 3})
+	ast.Nil(err)
+	ast.Equal([]byte("ab"), text)
+
+	_, err = pKCS5UnPadding([]byte{'a', 'b', 1, 2, 3})
+	ast.NotNil(err)
+
+	_, err = pKCS5UnPadding([]byte{'a', 'b', 0})
+	ast.NotNil(err)
+
+	_, err = pKCS5UnPadding([]byte{})
+	ast.NotNil(err)
+}
+
+func TestAesGCMEncrypt(t *testing.T) {
+	ast := assert.New(t)
+
+	rawKey := []byte("world")
+	text := []byte("this is a long text contains 中文。")
+	ad := []byte("additional data")
+
+	ciphertext, err := AesGCMEncrypt(text, rawKey, ad)
+	ast.Nil(err)
+	decodetext, err := AesGCMDecrypt(ciphertext, rawKey, ad)
+	ast.Nil(err)
+	ast.Equal(text, decodetext)
+
+	_, err = AesGCMDecrypt(ciphertext, []byte("world2"), ad)
+	ast.NotNil(err)
+
+	_, err = AesGCMDecrypt(ciphertext, rawKey, []byte("other data"))
+	ast.NotNil(err)
+
+	ciphertext[len(ciphertext)-1] ^= 0x01
+	_, err = AesGCMDecrypt(ciphertext, rawKey, ad)
+	ast.NotNil(err)
+
+	_, err = AesGCMDecrypt(ciphertext[:10], rawKey, ad)
+	ast.NotNil(err)
+}
+
 func BenchmarkAesDecrypt(b *testing.B) {
 	key := []byte("thisistestkey")
 	ciphertext, _ := base64.RawURLEncoding.DecodeString("XJorU1U1wyaSEef2tdLx5U--17mpjlQ1IqCymZeKXmKuwtx0uPOqcN91RTqUjsuwIOG2QX1jJ9JZzR6DxReDK9hl8sIEX5ieHiJ8rvNAoCiQlf8tFIgq1aOwn_8mEYys")
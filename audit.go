@@ -0,0 +1,271 @@
+package keyservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditEvent is record of a key operation, it never contains plaintext, ciphertext or key values
+type AuditEvent struct {
+	Time       time.Time `json:"time"`
+	RequestID  string    `json:"request_id,omitempty"`
+	Caller     string    `json:"caller,omitempty"`
+	Operation  string    `json:"op"`
+	KeyID      string    `json:"key_id,omitempty"`
+	KeyVersion uint16    `json:"key_version,omitempty"`
+	Code       int32     `json:"code"`
+	Msg        string    `json:"msg,omitempty"`
+}
+
+// AuditSink writes audit events
+type AuditSink interface {
+	WriteEvent(e *AuditEvent) error
+	Close() error
+}
+
+// Auditor writes audit events to all its sinks
+type Auditor struct {
+	sinks []AuditSink
+}
+
+// NewAuditor returns *Auditor that writes events to sinks
+func NewAuditor(sinks ...AuditSink) *Auditor {
+	return &Auditor{sinks: sinks}
+}
+
+// Audit writes event to sinks, errors are logged instead of returned,
+// so that failure of audit log doesn't break key operations.
+func (a *Auditor) Audit(e *AuditEvent) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	for _, sink := range a.sinks {
+		if err := sink.WriteEvent(e); err != nil {
+			logger.Errorf("audit err=%v", err)
+		}
+	}
+}
+
+// Close closes all sinks
+func (a *Auditor) Close() (err error) {
+	for _, sink := range a.sinks {
+		if e := sink.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return
+}
+
+type requestIDContextKey struct{}
+
+// ContextWithRequestID returns context with request id, which is recorded in audit events
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns request id, or empty string if not set
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+	return requestID
+}
+
+// OpenAuditSink opens sink by spec:
+//
+//	stdout        JSON lines to stdout
+//	syslog        JSON lines to syslog with tag "keyservice"
+//	file:<path>   JSON lines to file, rotated when it exceeds maxSize bytes, see NewFileAuditSink
+//
+// File records are hash chained and signed with chainKey if it's not nil, see NewChainedFileAuditSink.
+func OpenAuditSink(spec string, maxSize int64, maxBackups int, chainKey []byte) (AuditSink, error) {
+	switch {
+	case spec == "stdout":
+		return NewWriterAuditSink(os.Stdout), nil
+	case spec == "syslog":
+		return NewSyslogAuditSink("keyservice")
+	case strings.HasPrefix(spec, "file:"):
+		if chainKey != nil {
+			return NewChainedFileAuditSink(spec[len("file:"):], maxSize, maxBackups, chainKey)
+		}
+		return NewFileAuditSink(spec[len("file:"):], maxSize, maxBackups)
+	}
+	return nil, errors.New("unknown audit sink " + spec)
+}
+
+// WriterAuditSink writes events as JSON lines to writer
+type WriterAuditSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterAuditSink returns *WriterAuditSink, Close doesn't close w
+func NewWriterAuditSink(w io.Writer) *WriterAuditSink {
+	return &WriterAuditSink{w: w}
+}
+
+func (s *WriterAuditSink) WriteEvent(e *AuditEvent) error {
+	line, err := marshalAuditEvent(e)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(line)
+	return err
+}
+
+func (s *WriterAuditSink) Close() error {
+	return nil
+}
+
+// FileAuditSink writes events as JSON lines to file, and rotates the file when it exceeds max size:
+// file is renamed to file.1, file.1 to file.2 and so on, backups more than max backups are removed.
+type FileAuditSink struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	// HMAC key of hash chained records, nil if records are not chained
+	chainKey []byte
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+	// hash and seq of the last record, seq of the first record in the oldest file
+	prev     []byte
+	seq      uint64
+	firstSeq uint64
+}
+
+// NewFileAuditSink returns *FileAuditSink that appends to file,
+// maxSize <= 0 disables rotation, maxBackups <= 0 keeps all backups.
+func NewFileAuditSink(path string, maxSize int64, maxBackups int) (*FileAuditSink, error) {
+	s := &FileAuditSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileAuditSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileAuditSink) WriteEvent(e *AuditEvent) error {
+	line, err := marshalAuditEvent(e)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return os.ErrClosed
+	}
+
+	if s.chainKey != nil {
+		line = s.chain(line)
+	}
+
+	if s.maxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+		if err = s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(line)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+
+	if s.chainKey != nil {
+		return s.writeHead()
+	}
+	return nil
+}
+
+// rotate renames current file to backup and opens a new one
+func (s *FileAuditSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	s.file = nil
+
+	n := s.maxBackups
+	if n <= 0 {
+		// 保留所有备份，找到第一个不存在的序号
+		for n = 1; ; n++ {
+			if _, err := os.Stat(s.backup(n)); os.IsNotExist(err) {
+				break
+			}
+		}
+	} else if err := os.Remove(s.backup(n)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for i := n - 1; i >= 1; i-- {
+		if err := os.Rename(s.backup(i), s.backup(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.backup(1)); err != nil {
+		return err
+	}
+
+	if s.chainKey != nil {
+		// 最旧的备份可能已删除
+		if err := s.updateFirstSeq(); err != nil {
+			return err
+		}
+	}
+
+	return s.open()
+}
+
+func (s *FileAuditSink) backup(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+func (s *FileAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func marshalAuditEvent(e *AuditEvent) ([]byte, error) {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
@@ -0,0 +1,400 @@
+package keyservice
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+)
+
+// Hash chained audit record: JSON object of AuditEvent with three more fields appended,
+//
+//	{...,"seq":<sequence number>,"prev":"<hex SHA-256 of previous record line>","mac":"<hex HMAC-SHA256(chain key, record before mac)>"}
+//
+// so any modified, inserted or removed record breaks the chain, and records can't be forged without the key.
+// The chain continues across rotated files, seq of the first record ever is 1 and its prev is all zero.
+//
+// The sink also keeps a signed head(see AuditHead) next to the file, so that removed tail records
+// and removed oldest files are detected as well.
+var (
+	auditSeqField  = []byte(`,"seq":`)
+	auditPrevField = []byte(`,"prev":"`)
+	auditMACField  = []byte(`,"mac":"`)
+)
+
+// AuditHead is signed checkpoint of audit chain, written to <file>.head after each record.
+// An older head is still valid, copy it elsewhere periodically to detect rollback of both files and head.
+type AuditHead struct {
+	// seq of the first record in the oldest retained file
+	FirstSeq uint64 `json:"first_seq"`
+	// seq and hex SHA-256 of the last record
+	Seq  uint64 `json:"seq"`
+	Hash string `json:"hash"`
+	// hex HMAC-SHA256(chain key, fields above)
+	MAC string `json:"mac"`
+}
+
+func (h *AuditHead) sign(key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "audit-head:%d:%d:%s", h.FirstSeq, h.Seq, h.Hash)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ReadAuditHead reads head file written by chained sink and verifies its signature
+func ReadAuditHead(key []byte, file string) (*AuditHead, error) {
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	h := &AuditHead{}
+	if err = json.Unmarshal(contents, h); err != nil {
+		return nil, err
+	}
+	sig, err := hex.DecodeString(h.MAC)
+	if err != nil {
+		return nil, err
+	}
+	expected, _ := hex.DecodeString(h.sign(key))
+	if !hmac.Equal(sig, expected) {
+		return nil, errors.New("audit head mac mismatch, head was modified or signed with another key")
+	}
+	return h, nil
+}
+
+// 读取文件最后一行时每次读取的大小
+const auditTailChunkSize = 4096
+
+// AuditChainKey derives key of audit record HMAC from seed key
+func AuditChainKey(seedKey string) []byte {
+	mac := hmac.New(sha256.New, []byte(seedKey))
+	mac.Write([]byte("audit-chain"))
+	return mac.Sum(nil)
+}
+
+// NewChainedFileAuditSink is like NewFileAuditSink, records are hash chained and signed with key,
+// the chain continues from the last record of existing file. See VerifyAuditFiles.
+func NewChainedFileAuditSink(path string, maxSize int64, maxBackups int, key []byte) (*FileAuditSink, error) {
+	s := &FileAuditSink{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+		chainKey:   key,
+		prev:       make([]byte, sha256.Size),
+	}
+
+	// 当前文件为空时(刚轮转)，从最近的备份继续
+	for _, file := range []string{path, s.backup(1)} {
+		line, err := lastLine(file)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if len(line) > 0 {
+			seq, ok := auditRecordSeq(line)
+			if !ok {
+				return nil, fmt.Errorf("invalid last audit record of %s", file)
+			}
+			sum := sha256.Sum256(line)
+			s.prev = sum[:]
+			s.seq = seq
+			break
+		}
+	}
+
+	// 不在截断的链上继续写，否则新的 head 会掩盖截断
+	head, err := ReadAuditHead(key, path+".head")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if head != nil && head.Seq > s.seq {
+		return nil, fmt.Errorf("last audit record seq=%d, head seq=%d, records were removed", s.seq, head.Seq)
+	}
+
+	if err := s.updateFirstSeq(); err != nil {
+		return nil, err
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// updateFirstSeq finds seq of the first record in the oldest retained file
+func (s *FileAuditSink) updateFirstSeq() error {
+	files := []string{s.path}
+	for i := 1; ; i++ {
+		if _, err := os.Stat(s.backup(i)); err != nil {
+			break
+		}
+		files = append(files, s.backup(i))
+	}
+
+	s.firstSeq = s.seq + 1
+	for i := len(files) - 1; i >= 0; i-- {
+		line, err := firstLine(files[i])
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if len(line) > 0 {
+			seq, ok := auditRecordSeq(line)
+			if !ok {
+				return fmt.Errorf("invalid first audit record of %s", files[i])
+			}
+			s.firstSeq = seq
+			return nil
+		}
+	}
+	return nil
+}
+
+// writeHead writes signed head of the chain, it's replaced by rename so readers never see partial head
+func (s *FileAuditSink) writeHead() error {
+	h := &AuditHead{
+		FirstSeq: s.firstSeq,
+		Seq:      s.seq,
+		Hash:     hex.EncodeToString(s.prev),
+	}
+	h.MAC = h.sign(s.chainKey)
+	contents, err := json.Marshal(h)
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".head.tmp"
+	if err = ioutil.WriteFile(tmp, contents, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path+".head")
+}
+
+// chain appends prev hash and HMAC to record line, and updates prev hash
+func (s *FileAuditSink) chain(line []byte) []byte {
+	// line以"}\n"结尾
+	record := make([]byte, 0, len(line)+len(auditPrevField)+len(auditMACField)+4*sha256.Size+3)
+	s.seq++
+	record = append(record, line[:len(line)-2]...)
+	record = append(record, auditSeqField...)
+	record = strconv.AppendUint(record, s.seq, 10)
+	record = append(record, auditPrevField...)
+	record = append(record, hex.EncodeToString(s.prev)...)
+	record = append(record, '"')
+
+	mac := hmac.New(sha256.New, s.chainKey)
+	mac.Write(record)
+
+	record = append(record, auditMACField...)
+	record = append(record, hex.EncodeToString(mac.Sum(nil))...)
+	record = append(record, '"', '}')
+
+	sum := sha256.Sum256(record)
+	s.prev = sum[:]
+
+	return append(record, '\n')
+}
+
+// AuditVerifyError reports the first broken link of audit chain
+type AuditVerifyError struct {
+	File   string
+	Line   int
+	Reason string
+}
+
+func (e *AuditVerifyError) Error() string {
+	return fmt.Sprintf("audit chain broken at %s:%d: %s", e.File, e.Line, e.Reason)
+}
+
+// VerifyAuditFiles walks records of audit files written by chained sink in order(oldest first),
+// returns number of verified records, or *AuditVerifyError of the first broken link.
+// If head(see ReadAuditHead) is nil, removed tail records and removed oldest files can't be detected,
+// as prev of the first record is not checked unless it's the first record ever.
+func VerifyAuditFiles(key []byte, head *AuditHead, files ...string) (records int, err error) {
+	v := &auditVerifier{key: key, head: head}
+	for _, file := range files {
+		if err = v.verifyFile(file); err != nil {
+			return v.records, err
+		}
+	}
+
+	if head != nil && v.seq < head.Seq {
+		file := ""
+		if len(files) > 0 {
+			file = files[len(files)-1]
+		}
+		return v.records, &AuditVerifyError{
+			File:   file,
+			Line:   v.line + 1,
+			Reason: fmt.Sprintf("last record seq=%d, head seq=%d, records were removed from the end", v.seq, head.Seq),
+		}
+	}
+
+	return v.records, nil
+}
+
+type auditVerifier struct {
+	key  []byte
+	head *AuditHead
+
+	// seq and hash of the last verified record, line number of it in the current file
+	seq     uint64
+	prev    []byte
+	line    int
+	records int
+}
+
+func (v *auditVerifier) verifyFile(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for v.line = 1; ; v.line++ {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			v.line--
+			return nil
+		}
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if err == io.EOF {
+			return v.broken(file, "incomplete record")
+		}
+		if err = v.verifyRecord(file, line[:len(line)-1]); err != nil {
+			return err
+		}
+	}
+}
+
+func (v *auditVerifier) broken(file string, reason string) error {
+	return &AuditVerifyError{File: file, Line: v.line, Reason: reason}
+}
+
+func (v *auditVerifier) verifyRecord(file string, line []byte) error {
+	i := bytes.LastIndex(line, auditMACField)
+	if i < 0 || !bytes.HasSuffix(line, []byte(`"}`)) {
+		return v.broken(file, "missing mac")
+	}
+	sig, err := hex.DecodeString(string(line[i+len(auditMACField) : len(line)-2]))
+	if err != nil {
+		return v.broken(file, "invalid mac")
+	}
+	mac := hmac.New(sha256.New, v.key)
+	mac.Write(line[:i])
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return v.broken(file, "mac mismatch, record was modified or signed with another key")
+	}
+
+	j := bytes.LastIndex(line[:i], auditPrevField)
+	if j < 0 {
+		return v.broken(file, "missing prev")
+	}
+	recordPrev, err := hex.DecodeString(string(line[j+len(auditPrevField) : i-1]))
+	if err != nil || len(recordPrev) != sha256.Size {
+		return v.broken(file, "invalid prev")
+	}
+	seq, ok := auditRecordSeq(line)
+	if !ok {
+		return v.broken(file, "invalid seq")
+	}
+
+	switch {
+	case v.prev != nil:
+		if seq != v.seq+1 || !bytes.Equal(v.prev, recordPrev) {
+			return v.broken(file, "prev mismatch, previous record was modified, inserted or removed")
+		}
+	case seq == 1:
+		if !bytes.Equal(recordPrev, make([]byte, sha256.Size)) {
+			return v.broken(file, "prev of the first record is not zero")
+		}
+	}
+	if v.prev == nil && v.head != nil && seq != v.head.FirstSeq {
+		return v.broken(file, fmt.Sprintf("first record seq=%d, head first seq=%d, older records were removed", seq, v.head.FirstSeq))
+	}
+
+	sum := sha256.Sum256(line)
+	if v.head != nil && seq == v.head.Seq && hex.EncodeToString(sum[:]) != v.head.Hash {
+		return v.broken(file, "record doesn't match head")
+	}
+	v.prev = sum[:]
+	v.seq = seq
+	v.records++
+	return nil
+}
+
+// auditRecordSeq returns seq of chained record
+func auditRecordSeq(line []byte) (uint64, bool) {
+	i := bytes.LastIndex(line, auditSeqField)
+	if i < 0 {
+		return 0, false
+	}
+	digits := line[i+len(auditSeqField):]
+	if j := bytes.IndexByte(digits, ','); j >= 0 {
+		digits = digits[:j]
+	}
+	seq, err := strconv.ParseUint(string(digits), 10, 64)
+	return seq, err == nil && seq > 0
+}
+
+// firstLine returns the first line of file without newline
+func firstLine(file string) ([]byte, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	line, err := bufio.NewReader(f).ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return bytes.TrimSuffix(line, []byte{'\n'}), nil
+}
+
+// lastLine returns the last line of file without newline
+func lastLine(file string) ([]byte, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var line []byte
+	end := info.Size()
+	// 忽略结尾的换行
+	trim := true
+	for end > 0 {
+		start := end - auditTailChunkSize
+		if start < 0 {
+			start = 0
+		}
+		buf := make([]byte, end-start)
+		if _, err = f.ReadAt(buf, start); err != nil {
+			return nil, err
+		}
+		if trim && bytes.HasSuffix(buf, []byte{'\n'}) {
+			buf = buf[:len(buf)-1]
+		}
+		trim = false
+		if i := bytes.LastIndexByte(buf, '\n'); i >= 0 {
+			return append(buf[i+1:], line...), nil
+		}
+		line = append(buf, line...)
+		end = start
+	}
+
+	return line, nil
+}
@@ -0,0 +1,35 @@
+//go:build !windows
+// +build !windows
+
+package keyservice
+
+import (
+	"encoding/json"
+	"log/syslog"
+)
+
+// SyslogAuditSink writes events as JSON to syslog
+type SyslogAuditSink struct {
+	w *syslog.Writer
+}
+
+// NewSyslogAuditSink returns sink that writes to local syslog with facility LOG_AUTH
+func NewSyslogAuditSink(tag string) (AuditSink, error) {
+	w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogAuditSink{w: w}, nil
+}
+
+func (s *SyslogAuditSink) WriteEvent(e *AuditEvent) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return s.w.Info(string(line))
+}
+
+func (s *SyslogAuditSink) Close() error {
+	return s.w.Close()
+}
@@ -0,0 +1,11 @@
+//go:build windows
+// +build windows
+
+package keyservice
+
+import "errors"
+
+// NewSyslogAuditSink is not supported on windows
+func NewSyslogAuditSink(tag string) (AuditSink, error) {
+	return nil, errors.New("syslog is not supported on windows")
+}
@@ -0,0 +1,247 @@
+package keyservice
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditorWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	a := NewAuditor(NewWriterAuditSink(&buf))
+
+	a.Audit(&AuditEvent{
+		RequestID:  "req-1",
+		Caller:     "token:job",
+		Operation:  "Decrypt",
+		KeyID:      "key-1",
+		KeyVersion: 2,
+		Code:       0,
+	})
+	a.Audit(&AuditEvent{Operation: "Encrypt", KeyID: "key-2", Code: 1001, Msg: "Not found"})
+	require.NoError(t, a.Close())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var e AuditEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &e))
+	assert.Equal(t, "req-1", e.RequestID)
+	assert.Equal(t, "token:job", e.Caller)
+	assert.Equal(t, "Decrypt", e.Operation)
+	assert.Equal(t, "key-1", e.KeyID)
+	assert.Equal(t, uint16(2), e.KeyVersion)
+	assert.False(t, e.Time.IsZero())
+	assert.Contains(t, lines[1], `"code":1001`)
+}
+
+func TestFileAuditSinkRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	line, _ := marshalAuditEvent(&AuditEvent{Time: time.Unix(0, 0), Operation: "Encrypt", KeyID: "key"})
+
+	s, err := NewFileAuditSink(path, int64(len(line)*2), 2)
+	require.NoError(t, err)
+	for i := 0; i < 7; i++ {
+		require.NoError(t, s.WriteEvent(&AuditEvent{Time: time.Unix(0, 0), Operation: "Encrypt", KeyID: "key"}))
+	}
+	require.NoError(t, s.Close())
+
+	for _, file := range []string{path, path + ".1", path + ".2"} {
+		contents, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		assert.True(t, len(contents) > 0 && len(contents) <= len(line)*2, file)
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+
+	// reopen appends to existing file
+	s, err = NewFileAuditSink(path, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, s.WriteEvent(&AuditEvent{Operation: "Decrypt"}))
+	require.NoError(t, s.Close())
+	contents, _ := ioutil.ReadFile(path)
+	assert.Equal(t, 2, bytes.Count(contents, []byte("\n")))
+
+	_, err = OpenAuditSink("unknown", 0, 0, nil)
+	assert.Error(t, err)
+}
+
+func TestServiceKeyVersionOf(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	key := NewKey("value-1")
+	key.Current().Version = 3
+	key.Version = 3
+	s.Store("key-version-of", key)
+
+	encrypted, err := sv.Encrypt("hello", "key-version-of")
+	require.NoError(t, err)
+	bs, _ := base64.RawURLEncoding.DecodeString(encrypted)
+	assert.Equal(t, uint16(3), sv.KeyVersionOf(bs))
+
+	legacy, _ := base64.RawURLEncoding.DecodeString(encryptLegacy(sv, "hello", key))
+	assert.Equal(t, uint16(3), sv.KeyVersionOf(legacy))
+
+	assert.Equal(t, uint16(0), sv.KeyVersionOf(nil))
+	assert.Equal(t, uint16(0), sv.KeyVersionOf([]byte{0xff, 0, 1}))
+}
+
+func TestChainedFileAuditSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	key := AuditChainKey("seed-key")
+
+	s, err := NewChainedFileAuditSink(path, 1024, 0, key)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, s.WriteEvent(&AuditEvent{Operation: "Encrypt", KeyID: "key", KeyVersion: uint16(i)}))
+	}
+	require.NoError(t, s.Close())
+
+	// chain continues after reopen
+	s, err = NewChainedFileAuditSink(path, 1024, 0, key)
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, s.WriteEvent(&AuditEvent{Operation: "Decrypt", KeyID: "key"}))
+	}
+	require.NoError(t, s.Close())
+
+	files, _ := filepath.Glob(path + ".[0-9]*")
+	require.True(t, len(files) > 0)
+	ordered := make([]string, 0, len(files)+1)
+	for i := len(files); i >= 1; i-- {
+		ordered = append(ordered, fmt.Sprintf("%s.%d", path, i))
+	}
+	ordered = append(ordered, path)
+
+	head, err := ReadAuditHead(key, path+".head")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), head.FirstSeq)
+	assert.Equal(t, uint64(15), head.Seq)
+
+	records, err := VerifyAuditFiles(key, head, ordered...)
+	require.NoError(t, err)
+	assert.Equal(t, 15, records)
+	records, err = VerifyAuditFiles(key, nil, ordered...)
+	require.NoError(t, err)
+	assert.Equal(t, 15, records)
+
+	// wrong key
+	_, err = ReadAuditHead(AuditChainKey("other"), path+".head")
+	assert.NotNil(t, err)
+	_, err = VerifyAuditFiles(AuditChainKey("other"), nil, ordered...)
+	assert.IsType(t, &AuditVerifyError{}, err)
+
+	// missing file in the middle
+	if len(ordered) > 2 {
+		_, err = VerifyAuditFiles(key, head, append(ordered[:1:1], ordered[2:]...)...)
+		assert.Contains(t, err.Error(), "prev mismatch")
+	}
+
+	// dropped oldest file
+	_, err = VerifyAuditFiles(key, head, ordered[1:]...)
+	if assert.IsType(t, &AuditVerifyError{}, err) {
+		assert.Equal(t, ordered[1], err.(*AuditVerifyError).File)
+		assert.Contains(t, err.Error(), "older records were removed")
+	}
+	// removed first record
+	first, err := ioutil.ReadFile(ordered[0])
+	require.NoError(t, err)
+	firstLines := bytes.SplitAfter(first, []byte("\n"))
+	require.NoError(t, ioutil.WriteFile(ordered[0], bytes.Join(firstLines[1:], nil), 0600))
+	_, err = VerifyAuditFiles(key, head, ordered...)
+	if assert.IsType(t, &AuditVerifyError{}, err) {
+		assert.Contains(t, err.Error(), "older records were removed")
+	}
+	require.NoError(t, ioutil.WriteFile(ordered[0], first, 0600))
+
+	contents, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := bytes.SplitAfter(contents, []byte("\n"))
+
+	// truncated tail
+	require.True(t, len(lines) > 2)
+	for _, n := range []int{len(lines) - 2, 0} {
+		require.NoError(t, ioutil.WriteFile(path, bytes.Join(lines[:n], nil), 0600))
+		_, err = VerifyAuditFiles(key, head, ordered...)
+		if assert.IsType(t, &AuditVerifyError{}, err) {
+			assert.Equal(t, path, err.(*AuditVerifyError).File)
+			assert.Contains(t, err.Error(), "records were removed from the end")
+		}
+	}
+	// sink doesn't continue truncated chain
+	_, err = NewChainedFileAuditSink(path, 1024, 0, key)
+	assert.Contains(t, err.Error(), "records were removed")
+
+	// restored
+	require.NoError(t, ioutil.WriteFile(path, contents, 0600))
+	records, err = VerifyAuditFiles(key, head, ordered...)
+	require.NoError(t, err)
+	assert.Equal(t, 15, records)
+
+	// modified record
+	modified := bytes.Replace(contents, []byte(`"op":"Decrypt"`), []byte(`"op":"Encrypt"`), 1)
+	require.NoError(t, ioutil.WriteFile(path, modified, 0600))
+	_, err = VerifyAuditFiles(key, nil, path)
+	if assert.IsType(t, &AuditVerifyError{}, err) {
+		assert.Contains(t, err.Error(), "mac mismatch")
+	}
+
+	// removed record
+	if len(lines) > 3 {
+		removed := bytes.Join(append(lines[:1:1], lines[2:]...), nil)
+		require.NoError(t, ioutil.WriteFile(path, removed, 0600))
+		_, err = VerifyAuditFiles(key, nil, path)
+		if assert.IsType(t, &AuditVerifyError{}, err) {
+			assert.Equal(t, 2, err.(*AuditVerifyError).Line)
+			assert.Contains(t, err.Error(), "prev mismatch")
+		}
+	}
+}
+
+func TestChainedFileAuditSinkMaxBackups(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.log")
+	key := AuditChainKey("seed-key")
+
+	s, err := NewChainedFileAuditSink(path, 512, 2, key)
+	require.NoError(t, err)
+	for i := 0; i < 30; i++ {
+		require.NoError(t, s.WriteEvent(&AuditEvent{Operation: "Encrypt", KeyID: "key"}))
+	}
+	require.NoError(t, s.Close())
+
+	// removed backups are excluded from head
+	head, err := ReadAuditHead(key, path+".head")
+	require.NoError(t, err)
+	assert.True(t, head.FirstSeq > 1)
+	assert.Equal(t, uint64(30), head.Seq)
+
+	records, err := VerifyAuditFiles(key, head, path+".2", path+".1", path)
+	require.NoError(t, err)
+	assert.Equal(t, int(head.Seq-head.FirstSeq+1), records)
+
+	_, err = VerifyAuditFiles(key, head, path+".1", path)
+	assert.IsType(t, &AuditVerifyError{}, err)
+}
@@ -0,0 +1,75 @@
+package keyservice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// 盲索引中HMAC结果截断后的字节数
+var blindIndexSize = 16
+
+// BlindIndex returns keyed hash of value for lookups on encrypted data,
+// store it alongside the encrypted data and query by the index instead of plaintext.
+// Index format: base64url(version(2 bytes)+truncated HMAC-SHA256(index key, value)),
+// index key is derived from the current version of key specified by keyID.
+// After key rotation new indexes differ, use BlindIndexes to query data indexed by older versions.
+func (sv *KeyService) BlindIndex(value string, keyID string) (string, error) {
+	key, err := sv.LookupKey(keyID)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !key.IsActive() {
+		return "", ErrKeyDisabled
+	}
+
+	return sv.blindIndex(value, key.Version, key.Current().Value), nil
+}
+
+// BlindIndexes returns indexes of value with all usable versions of key, the current version first,
+// e.g. query by `WHERE phone_index IN (...)` to find data indexed before key rotation.
+func (sv *KeyService) BlindIndexes(value string, keyID string) ([]string, error) {
+	key, err := sv.LookupKey(keyID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.Enabled() {
+		return nil, ErrKeyDisabled
+	}
+
+	indexes := make([]string, 0, len(key.Versions))
+	if keyValue := key.valueOf(key.Version); keyValue != "" {
+		indexes = append(indexes, sv.blindIndex(value, key.Version, keyValue))
+	}
+	for i := len(key.Versions) - 1; i >= 0; i-- {
+		v := key.Versions[i]
+		if v.Version == key.Version {
+			continue
+		}
+		if keyValue := key.valueOf(v.Version); keyValue != "" {
+			indexes = append(indexes, sv.blindIndex(value, v.Version, keyValue))
+		}
+	}
+
+	return indexes, nil
+}
+
+func (sv *KeyService) blindIndex(value string, version uint16, keyValue string) string {
+	// 使用独立的索引密钥，与加密密钥隔离
+	indexKey := hmac.New(sha256.New, sv.deriveKey(keyValue))
+	indexKey.Write([]byte("blind-index"))
+
+	mac := hmac.New(sha256.New, indexKey.Sum(nil))
+	mac.Write([]byte(value))
+
+	bs := make([]byte, 2, 2+blindIndexSize)
+	bs[0] = byte(version >> 8)
+	bs[1] = byte(version & 0xff)
+	bs = append(bs, mac.Sum(nil)[:blindIndexSize]...)
+
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
@@ -0,0 +1,54 @@
+package keyservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlindIndex(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	index, err := sv.BlindIndex("13800138000", _testKeyId2)
+	require.NoError(t, err)
+	assert.NotContains(t, index, "13800138000")
+
+	index2, err := sv.BlindIndex("13800138000", _testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, index, index2)
+
+	index2, _ = sv.BlindIndex("13800138001", _testKeyId2)
+	assert.NotEqual(t, index, index2)
+	index2, _ = sv.BlindIndex("13800138000", _testKeyId1)
+	assert.NotEqual(t, index, index2)
+	index2, _ = NewKeyService("other-seed-key", newTestStorage(), newTestCache()).BlindIndex("13800138000", _testKeyId2)
+	assert.NotEqual(t, index, index2)
+
+	indexes, err := sv.BlindIndexes("13800138000", _testKeyId2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(indexes))
+	assert.Equal(t, index, indexes[0])
+
+	// rotation
+	_, err = sv.RotateKey(_testKeyId2)
+	require.NoError(t, err)
+	index2, err = sv.BlindIndex("13800138000", _testKeyId2)
+	require.NoError(t, err)
+	assert.NotEqual(t, index, index2)
+	indexes, err = sv.BlindIndexes("13800138000", _testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(indexes))
+	assert.Equal(t, index2, indexes[0])
+	assert.Equal(t, index, indexes[1])
+
+	_, err = sv.BlindIndex("13800138000", "key-not-exists-id")
+	assert.Equal(t, ErrNotFound, err)
+
+	_, err = sv.DisableKey(_testKeyId2)
+	require.NoError(t, err)
+	_, err = sv.BlindIndex("13800138000", _testKeyId2)
+	assert.Equal(t, ErrKeyDisabled, err)
+	_, err = sv.BlindIndexes("13800138000", _testKeyId2)
+	assert.Equal(t, ErrKeyDisabled, err)
+}
@@ -0,0 +1,167 @@
+package keyservice
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var boltKeysBucket = []byte("keys")
+
+// BoltStorage stores keys in embedded bolt database, one record per key.
+// Each record is encrypted with secret.
+type BoltStorage struct {
+	db     *bolt.DB
+	secret string
+}
+
+// NewBoltStorage opens bolt database at path, creates it if not exists
+func NewBoltStorage(path string, secret string) (*BoltStorage, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltKeysBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltStorage{
+		db:     db,
+		secret: secret,
+	}, nil
+}
+
+// Close closes bolt database
+func (s *BoltStorage) Close() error {
+	return s.db.Close()
+}
+
+func (s *BoltStorage) Store(id string, key *Key) error {
+	data, err := s.encode(key)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltKeysBucket).Put([]byte(id), data)
+	})
+}
+
+// CompareAndStore saves key only if the stored key equals old,
+// the comparison and write are done in one transaction.
+func (s *BoltStorage) CompareAndStore(id string, old, key *Key) error {
+	data, err := s.encode(key)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(boltKeysBucket)
+		current, err := s.decode(b.Get([]byte(id)))
+		if err != nil {
+			return err
+		}
+		if !sameKey(current, old) {
+			return ErrConflict
+		}
+		return b.Put([]byte(id), data)
+	})
+}
+
+func (s *BoltStorage) encode(key *Key) ([]byte, error) {
+	if key == nil {
+		return nil, errors.New("nil key")
+	}
+	data, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	return AesEncrypt(data, []byte(s.secret))
+}
+
+// decode returns nil if data is nil
+func (s *BoltStorage) decode(data []byte) (*Key, error) {
+	if data == nil {
+		return nil, nil
+	}
+	// value is only valid during the transaction
+	data, err := AesDecrypt(append([]byte{}, data...), []byte(s.secret))
+	if err != nil {
+		return nil, err
+	}
+	key := &Key{}
+	if err = json.Unmarshal(data, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Delete removes key
+func (s *BoltStorage) Delete(id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltKeysBucket).Delete([]byte(id))
+	})
+}
+
+// CompareAndDelete removes key only if the stored key equals old
+func (s *BoltStorage) CompareAndDelete(id string, old *Key) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(boltKeysBucket)
+		current, err := s.decode(b.Get([]byte(id)))
+		if err != nil {
+			return err
+		}
+		if !sameKey(current, old) {
+			return ErrConflict
+		}
+		return b.Delete([]byte(id))
+	})
+}
+
+func (s *BoltStorage) LoadMany(ids []string) (ret map[string]*Key, err error) {
+	ret = make(map[string]*Key)
+
+	err = s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(boltKeysBucket)
+		for _, id := range ids {
+			key, err := s.decode(b.Get([]byte(id)))
+			if err != nil {
+				return err
+			}
+			if key != nil {
+				ret[id] = key
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// IDs returns ids of all keys
+func (s *BoltStorage) IDs() ([]string, error) {
+	ids := make([]string, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltKeysBucket).ForEach(func(k, _ []byte) error {
+			ids = append(ids, string(k))
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
@@ -0,0 +1,72 @@
+package keyservice
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltStorage(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-bolt-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	defer os.Remove(file)
+
+	s, err := NewBoltStorage(file, "secret-key")
+	require.NoError(t, err)
+
+	key1 := NewKey("key1-value")
+	require.NoError(t, s.Store("key1", key1))
+	require.NoError(t, s.Store("key2", _testKey2))
+
+	keys, err := s.LoadMany([]string{"key1", "key2", "key-not-exists"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+	assert.EqualValues(t, key1, keys["key1"])
+	assert.EqualValues(t, _testKey2, keys["key2"])
+
+	sv := NewKeyService("seed-key", s, NoCache)
+	key, err := sv.RotateKey("key1")
+	require.NoError(t, err)
+	keys, err = s.LoadMany([]string{"key1"})
+	require.NoError(t, err)
+	assert.EqualValues(t, key, keys["key1"])
+
+	// conditional write
+	assert.Equal(t, ErrConflict, s.CompareAndStore("key1", key1, key1))
+	assert.Equal(t, ErrConflict, s.CompareAndStore("key1", nil, key1))
+	require.NoError(t, s.CompareAndStore("key1", key, key1))
+	key3 := NewKey("key3-value")
+	key3.State = KeyStatePendingDeletion
+	key3.PreviousState = KeyStateDisabled
+	key3.DeletionTime = time.Now().Unix()
+	require.NoError(t, s.CompareAndStore("key3", nil, key3))
+	keys, err = s.LoadMany([]string{"key3"})
+	require.NoError(t, err)
+	assert.EqualValues(t, key3, keys["key3"])
+	assert.Equal(t, ErrConflict, s.CompareAndDelete("key3", key1))
+	require.NoError(t, s.CompareAndDelete("key3", key3))
+	keys, err = s.LoadMany([]string{"key3"})
+	require.NoError(t, err)
+	assert.Nil(t, keys["key3"])
+
+	ids, err := s.IDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key1", "key2"}, ids)
+
+	require.NoError(t, s.Delete("key1"))
+	require.NoError(t, s.Close())
+
+	// reopen
+	s, err = NewBoltStorage(file, "secret-key")
+	require.NoError(t, err)
+	defer s.Close()
+	keys, err = s.LoadMany([]string{"key1", "key2"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(keys))
+	assert.EqualValues(t, _testKey2, keys["key2"])
+}
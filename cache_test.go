@@ -8,6 +8,7 @@ import (
 )
 
 type testCache struct {
+	mu          sync.Mutex
 	data        sync.Map
 	storeStat   map[string]int
 	loadStat    map[string]int
@@ -23,12 +24,16 @@ func newTestCache() *testCache {
 }
 
 func (c *testCache) Store(id string, content []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.storeStat[id] += 1
 	c.data.Store(id, content)
 	return nil
 }
 
 func (c *testCache) Load(id string) (content []byte, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.loadStat[id] += 1
 	if v, ok := c.data.Load(id); ok {
 		c.loadHitStat[id] += 1
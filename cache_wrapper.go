@@ -2,15 +2,21 @@ package keyservice
 
 import (
 	"encoding/json"
+	"errors"
 	"sync"
 	"time"
 
-	"github.com/pkg/errors"
+	pkgerrors "github.com/pkg/errors"
 )
 
+// errNotFoundCached is returned by cacheWrapper.Load if key is cached as not found in storage
+var errNotFoundCached = errors.New("Not found(cached)")
+
 type cacheItem struct {
 	Value      *Key      `json:"v"`
 	Expiration time.Time `json:"e"`
+	// 最近一次从storage加载(或写入storage)成功的时间，storage故障时据此判断能否继续使用
+	LoadedAt time.Time `json:"l"`
 }
 
 func (c *cacheItem) isExpired() bool {
@@ -22,7 +28,9 @@ type cacheWrapper struct {
 	cache  Cache
 
 	expireTime time.Duration
-	buffer     sync.Map
+
+	mu     sync.RWMutex
+	memory *memoryCache
 }
 
 func newCacheWrapper(cache Cache, cipher Cipher, cacheTime time.Duration) *cacheWrapper {
@@ -30,17 +38,41 @@ func newCacheWrapper(cache Cache, cipher Cipher, cacheTime time.Duration) *cache
 		cache:      cache,
 		cipher:     cipher,
 		expireTime: cacheTime,
+		memory:     newMemoryCache(DefaultMemoryCacheOptions),
 	}
 }
 
+func (w *cacheWrapper) buffer() *memoryCache {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.memory
+}
+
+// setMemoryCache replaces memory cache, cached items are dropped
+func (w *cacheWrapper) setMemoryCache(opts MemoryCacheOptions) {
+	w.mu.Lock()
+	w.memory = newMemoryCache(opts)
+	w.mu.Unlock()
+}
+
 func (w *cacheWrapper) Load(id string) (key *Key, err error) {
-	if v, ok := w.buffer.Load(id); ok {
-		item := v.(*cacheItem)
+	item, err := w.LoadItem(id)
+	if item != nil {
 		key = item.Value
+	}
+	return
+}
+
+// LoadItem is like Load, returns cache item with expiration time
+func (w *cacheWrapper) LoadItem(id string) (item *cacheItem, err error) {
+	if item, ok := w.buffer().Get(id); ok {
+		if item.Value == nil {
+			return nil, errNotFoundCached
+		}
 		if item.isExpired() {
 			err = ErrExpired
 		}
-		return
+		return item, err
 	}
 
 	cipherData, err := w.cache.Load(id)
@@ -54,7 +86,7 @@ func (w *cacheWrapper) Load(id string) (key *Key, err error) {
 	data, err := w.cipher.Decrypt(cipherData)
 
 	if err != nil {
-		err = errors.Wrap(err, "cacheWrapper.Load decrypt")
+		err = pkgerrors.Wrap(err, "cacheWrapper.Load decrypt")
 		logger.Error(err)
 		return
 	}
@@ -62,49 +94,103 @@ func (w *cacheWrapper) Load(id string) (key *Key, err error) {
 	val := &cacheItem{}
 	err = json.Unmarshal(data, val)
 	if err != nil {
-		err = errors.Wrap(err, "cacheWrapper.Load unmarshal")
+		err = pkgerrors.Wrap(err, "cacheWrapper.Load unmarshal")
 		logger.Error(err)
 		return
 	}
 
-	w.buffer.Store(id, val)
+	if val.Value == nil {
+		return nil, ErrNotFound
+	}
+
+	w.buffer().Set(id, val)
 
-	key = val.Value
 	if val.isExpired() {
 		err = ErrExpired
 	}
 
 	logger.Debugf("load cache %s", id)
 
-	return key, err
+	return val, err
 }
 
 func (w *cacheWrapper) Store(id string, key *Key) error {
+	now := time.Now()
 	item := &cacheItem{
 		Value:      key,
-		Expiration: time.Now().Add(w.expireTime),
+		Expiration: now.Add(w.expireTime),
+		LoadedAt:   now,
 	}
 
-	w.buffer.Store(id, item)
-
 	logger.Debugf("store cache %s", id)
 
+	return w.storeItem(id, item)
+}
+
+// Expire marks cached key as expired, so that it will be reloaded from storage next time.
+// The expired key is still available if storage fails.
+func (w *cacheWrapper) Expire(id string) error {
+	item, err := w.LoadItem(id)
+	if item == nil {
+		if err == errNotFoundCached {
+			// key may be created
+			w.buffer().Delete(id)
+			return nil
+		}
+		if err == ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	logger.Debugf("expire cache %s", id)
+
+	return w.storeItem(id, &cacheItem{
+		Value:    item.Value,
+		LoadedAt: item.LoadedAt,
+	})
+}
+
+// StoreNotFound caches that key is not found in storage, it's kept in memory only
+func (w *cacheWrapper) StoreNotFound(id string) {
+	w.buffer().Set(id, &cacheItem{})
+}
+
+// Delete removes cached key
+func (w *cacheWrapper) Delete(id string) error {
+	w.buffer().Delete(id)
+
+	logger.Debugf("delete cache %s", id)
+
+	// empty content is treated as not found
+	if err := w.cache.Store(id, nil); err != nil {
+		err = pkgerrors.Wrap(err, "cacheWrapper.Delete store")
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (w *cacheWrapper) storeItem(id string, item *cacheItem) error {
+	w.buffer().Set(id, item)
+
 	data, err := json.Marshal(item)
 	if err != nil {
-		err = errors.Wrap(err, "cacheWrapper.Store marshal")
+		err = pkgerrors.Wrap(err, "cacheWrapper.Store marshal")
 		logger.Error(err)
 		return err
 	}
 
 	cipherData, err := w.cipher.Encrypt(data)
 	if err != nil {
-		err = errors.Wrap(err, "cacheWrapper.Store encrypt")
+		err = pkgerrors.Wrap(err, "cacheWrapper.Store encrypt")
 		logger.Error(err)
 		return err
 	}
 
 	if err = w.cache.Store(id, cipherData); err != nil {
-		err = errors.Wrap(err, "cacheWrapper.Store store")
+		err = pkgerrors.Wrap(err, "cacheWrapper.Store store")
 		logger.Error(err)
 		return err
 	}
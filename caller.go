@@ -0,0 +1,17 @@
+package keyservice
+
+import "context"
+
+type callerContextKey struct{}
+
+// ContextWithCaller returns context with caller identity,
+// identity is prefixed by its source, e.g. "cert:order-service", "token:report-job".
+func ContextWithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerContextKey{}, caller)
+}
+
+// CallerFromContext returns caller identity, or empty string if caller is anonymous
+func CallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerContextKey{}).(string)
+	return caller
+}
@@ -7,13 +7,32 @@ import (
 	"github.com/techxmind/keyservice"
 	"io/ioutil"
 	"os"
+	"strings"
+	"time"
 )
 
 var (
 
 )
 
+// usage:
+//   keyservice [flags]         encrypt/decrypt text
+//   keyservice rotate [flags]  rotate key specified by -key
+//   keyservice token [flags]   issue API token specified by -name, signed with -token_secret
+//   keyservice audit verify -seedkey <seed key> [-head <head file>] <file>...
+//                              verify hash chain of audit files, rotated files first(e.g. audit.log.2 audit.log.1 audit.log),
+//                              against signed head of the chain(<last file>.head by default)
 func main() {
+	command := ""
+	args := os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+	subCommand := ""
+	if command == "audit" && len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		subCommand, args = args[0], args[1:]
+	}
+
 	ksFile := flag.String("keystore", "", "keystore file")
 	ksSourceFile := flag.String("source", "", "keystore source file that used to generate keystore file")
 	secret := flag.String("secret", "", "secret key to encrypt/decrypt file contents")
@@ -21,7 +40,48 @@ func main() {
 	keyId := flag.String("key", "", "key id used to encrypt/decrypt")
 	encryptText := flag.String("encrypt", "", "text needs to be encrypted")
 	decryptText := flag.String("decrypt", "", "text needs to be decrypted")
-	flag.Parse()
+	tokenName := flag.String("name", "", "name of API token")
+	tokenSecret := flag.String("token_secret", "", "secret key to sign API token")
+	tokenTTL := flag.Duration("ttl", 24*time.Hour, "API token lifetime")
+	auditHead := flag.String("head", "", "signed head file of audit chain, <last audit file>.head by default, none to skip")
+	flag.CommandLine.Parse(args)
+
+	if command == "token" {
+		token, err := keyservice.SignToken(*tokenSecret, *tokenName, time.Now().Add(*tokenTTL))
+		if err != nil {
+			error("token_secret and name required")
+		}
+		fmt.Println(token)
+		return
+	}
+
+	if command == "audit" {
+		if subCommand != "verify" {
+			error("unknown audit command:%s", subCommand)
+		}
+		if *seedKey == "" || flag.NArg() == 0 {
+			error("seedkey and audit files required")
+		}
+		key := keyservice.AuditChainKey(*seedKey)
+		var head *keyservice.AuditHead
+		if *auditHead != "none" {
+			if *auditHead == "" {
+				*auditHead = flag.Arg(flag.NArg()-1) + ".head"
+			}
+			h, err := keyservice.ReadAuditHead(key, *auditHead)
+			if err != nil {
+				error("read audit head error:%v", err)
+			}
+			head = h
+		}
+		records, err := keyservice.VerifyAuditFiles(key, head, flag.Args()...)
+		if err != nil {
+			fmt.Printf("verify error after %d records: %v\n", records, err)
+			os.Exit(1)
+		}
+		fmt.Printf("verify result: %d records ok\n", records)
+		return
+	}
 
 	if *ksFile == "" {
 		error("keystore required")
@@ -61,6 +121,19 @@ func main() {
 		error("no key id specified")
 	}
 
+	switch command {
+	case "":
+	case "rotate":
+		key, err := service.RotateKey(*keyId)
+		if err != nil {
+			error("rotate error:%v", err)
+		}
+		fmt.Printf("rotate result: version %d\n", key.Version)
+		return
+	default:
+		error("unknown command:%s", command)
+	}
+
 	if *encryptText != "" {
 		value, err := service.Encrypt(*encryptText, *keyId)
 		if err != nil {
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type Config struct {
@@ -11,6 +12,39 @@ type Config struct {
 	HTTPAddr    string `json:"http_addr"`
 	DebugAddr   string `json:"debug_addr"`
 	GRPCAddr    string `json:"grpc_addr"`
+	Keystore    string `json:"keystore"` // keystore file path
+	Secret      string `json:"secret"`   // secret key to decrypt keystore file or key values in storage
+	SeedKey     string `json:"seed_key"` // seed key for KeyService
+
+	StorageDriver string `json:"storage_driver"` // bolt or database/sql driver name, use keystore file if empty
+	StorageDSN    string `json:"storage_dsn"`    // data source name, database file path for bolt
+
+	RotationInterval time.Duration `json:"rotation_interval"` // interval to check keys needing rotation or deletion, 0 to disable
+
+	CacheSize        int           `json:"cache_size"`         // max keys in memory cache, 0 to disable memory cache
+	CacheTTL         time.Duration `json:"cache_ttl"`          // max time a key stays in memory cache
+	CacheNegativeTTL time.Duration `json:"cache_negative_ttl"` // time a missing key id is cached, 0 to disable
+	MaxStaleness     time.Duration `json:"max_staleness"`      // time an expired key is served while refreshing in background, 0 to always wait
+	RefreshAhead     time.Duration `json:"refresh_ahead"`      // time before expiry to refresh keys in background, 0 to disable
+
+	MaxKeyAge               time.Duration `json:"max_key_age"`               // max age of cached keys served during storage errors, 0 for no limit
+	StorageFailureThreshold int           `json:"storage_failure_threshold"` // consecutive storage failures to stop accessing it, 0 to disable
+	StorageOpenTimeout      time.Duration `json:"storage_open_timeout"`      // time to wait before accessing storage again after stopped
+
+	TLSCertFile          string `json:"tls_cert_file"`           // server certificate, TLS is enabled on HTTP and gRPC listeners if set
+	TLSKeyFile           string `json:"tls_key_file"`            // server private key
+	TLSClientCAFile      string `json:"tls_client_ca_file"`      // CA bundle to verify client certificates
+	TLSRequireClientCert bool   `json:"tls_require_client_cert"` // require client certificates(mTLS)
+
+	PolicyFile   string `json:"policy_file"`   // authorization policies file, all callers are allowed if empty
+	CallerHeader string `json:"caller_header"` // header(gRPC metadata) of trusted caller identity, e.g. set by proxy
+
+	TokensFile  string `json:"tokens_file"`  // static API tokens file, see keyservice.NewTokenAuthenticator
+	TokenSecret string `json:"token_secret"` // secret to verify signed API tokens, token authentication is enabled if it or TokensFile is set
+
+	AuditSinks      string `json:"audit_sinks"`       // comma separated audit sinks: stdout, syslog, file:<path>, audit is disabled if empty
+	AuditMaxSize    int64  `json:"audit_max_size"`    // max bytes of audit file before rotation, 0 to disable rotation
+	AuditMaxBackups int    `json:"audit_max_backups"` // max rotated audit files to keep, 0 to keep all
 }
 
 func (c *Config) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
@@ -4,6 +4,8 @@ import (
 	"flag"
 	"fmt"
 	"os"
+
+	"github.com/techxmind/keyservice"
 )
 
 var (
@@ -16,6 +18,31 @@ func init() {
 	flag.StringVar(&DefaultConfig.DebugAddr, "debug.addr", ":5060", "Debug and metrics listen address")
 	flag.StringVar(&DefaultConfig.HTTPAddr, "http.addr", ":5050", "HTTP listen address")
 	flag.StringVar(&DefaultConfig.GRPCAddr, "grpc.addr", ":5040", "gRPC (HTTP) listen address")
+	flag.StringVar(&DefaultConfig.Keystore, "keystore", "", "Keystore file")
+	flag.StringVar(&DefaultConfig.Secret, "secret", "", "Secret key to decrypt keystore file")
+	flag.StringVar(&DefaultConfig.SeedKey, "seedkey", "", "Seed key for keyservice")
+	flag.StringVar(&DefaultConfig.StorageDriver, "storage.driver", "", "Storage driver: bolt or database/sql driver name(postgres, mysql or sqlite3), use keystore file if empty")
+	flag.StringVar(&DefaultConfig.StorageDSN, "storage.dsn", "", "Storage data source name, database file path for bolt")
+	flag.StringVar(&DefaultConfig.TLSCertFile, "tls.cert", "", "TLS certificate file, TLS is enabled on HTTP and gRPC listeners if set, reloaded on change")
+	flag.StringVar(&DefaultConfig.TLSKeyFile, "tls.key", "", "TLS private key file")
+	flag.StringVar(&DefaultConfig.TLSClientCAFile, "tls.client_ca", "", "CA bundle file to verify client certificates")
+	flag.BoolVar(&DefaultConfig.TLSRequireClientCert, "tls.require_client_cert", false, "Require client certificates(mTLS)")
+	flag.StringVar(&DefaultConfig.PolicyFile, "policy", "", "Authorization policies file, all callers are allowed if empty")
+	flag.StringVar(&DefaultConfig.CallerHeader, "caller.header", "", "Header(gRPC metadata) of trusted caller identity, only when callers are authenticated by proxy")
+	flag.StringVar(&DefaultConfig.TokensFile, "auth.tokens", "", "Static API tokens file, requests must carry valid token or client certificate if set")
+	flag.StringVar(&DefaultConfig.TokenSecret, "auth.token_secret", "", "Secret to verify signed API tokens, requests must carry valid token or client certificate if set")
+	flag.StringVar(&DefaultConfig.AuditSinks, "audit", "", "Comma separated audit sinks: stdout, syslog, file:<path>, audit is disabled if empty")
+	flag.Int64Var(&DefaultConfig.AuditMaxSize, "audit.max_size", 100*1024*1024, "Max bytes of audit file before rotation, 0 to disable rotation")
+	flag.IntVar(&DefaultConfig.AuditMaxBackups, "audit.max_backups", 10, "Max rotated audit files to keep, 0 to keep all")
+	flag.DurationVar(&DefaultConfig.RotationInterval, "rotation.interval", 0, "Interval to check keys needing rotation or deletion, 0 to disable. Enable it on one instance only")
+	flag.IntVar(&DefaultConfig.CacheSize, "cache.size", keyservice.DefaultMemoryCacheOptions.Size, "Max keys in memory cache, 0 to disable memory cache")
+	flag.DurationVar(&DefaultConfig.CacheTTL, "cache.ttl", keyservice.DefaultMemoryCacheOptions.TTL, "Max time a key stays in memory cache")
+	flag.DurationVar(&DefaultConfig.CacheNegativeTTL, "cache.negative_ttl", keyservice.DefaultMemoryCacheOptions.NegativeTTL, "Time a missing key id is cached, 0 to disable")
+	flag.DurationVar(&DefaultConfig.MaxStaleness, "cache.max_staleness", keyservice.DefaultRefreshOptions.MaxStaleness, "Time an expired key is served while refreshing in background, requests wait for storage after it, 0 to always wait")
+	flag.DurationVar(&DefaultConfig.RefreshAhead, "cache.refresh_ahead", keyservice.DefaultRefreshOptions.RefreshAhead, "Time before expiry to refresh keys in background, 0 to disable")
+	flag.DurationVar(&DefaultConfig.MaxKeyAge, "storage.max_key_age", keyservice.DefaultResilienceOptions.MaxKeyAge, "Max age of cached keys served during storage errors, 0 for no limit")
+	flag.IntVar(&DefaultConfig.StorageFailureThreshold, "storage.failure_threshold", keyservice.DefaultResilienceOptions.FailureThreshold, "Consecutive storage failures to stop accessing it for storage.open_timeout, 0 to disable")
+	flag.DurationVar(&DefaultConfig.StorageOpenTimeout, "storage.open_timeout", keyservice.DefaultResilienceOptions.OpenTimeout, "Time to wait before accessing storage again after consecutive failures")
 
 	// Use environment variables, if set. Flags have priority over Env vars.
 	if addr := os.Getenv("DEBUG_ADDR"); addr != "" {
@@ -30,4 +57,40 @@ func init() {
 	if addr := os.Getenv("GRPC_ADDR"); addr != "" {
 		DefaultConfig.GRPCAddr = addr
 	}
+	if file := os.Getenv("KEYSTORE"); file != "" {
+		DefaultConfig.Keystore = file
+	}
+	if secret := os.Getenv("KEYSTORE_SECRET"); secret != "" {
+		DefaultConfig.Secret = secret
+	}
+	if seedKey := os.Getenv("SEED_KEY"); seedKey != "" {
+		DefaultConfig.SeedKey = seedKey
+	}
+	if driver := os.Getenv("STORAGE_DRIVER"); driver != "" {
+		DefaultConfig.StorageDriver = driver
+	}
+	if dsn := os.Getenv("STORAGE_DSN"); dsn != "" {
+		DefaultConfig.StorageDSN = dsn
+	}
+	if file := os.Getenv("POLICY_FILE"); file != "" {
+		DefaultConfig.PolicyFile = file
+	}
+	if file := os.Getenv("TLS_CERT_FILE"); file != "" {
+		DefaultConfig.TLSCertFile = file
+	}
+	if file := os.Getenv("TLS_KEY_FILE"); file != "" {
+		DefaultConfig.TLSKeyFile = file
+	}
+	if file := os.Getenv("TLS_CLIENT_CA_FILE"); file != "" {
+		DefaultConfig.TLSClientCAFile = file
+	}
+	if file := os.Getenv("AUTH_TOKENS_FILE"); file != "" {
+		DefaultConfig.TokensFile = file
+	}
+	if secret := os.Getenv("AUTH_TOKEN_SECRET"); secret != "" {
+		DefaultConfig.TokenSecret = secret
+	}
+	if sinks := os.Getenv("AUDIT_SINKS"); sinks != "" {
+		DefaultConfig.AuditSinks = sinks
+	}
 }
@@ -0,0 +1,55 @@
+package keyservice
+
+import (
+	"encoding/base64"
+	"errors"
+)
+
+var ErrInvalidDataKey = errors.New("Invalid data key")
+
+// 数据密钥字节数(AES-256)
+var dataKeySize = 32
+
+// GenerateDataKey generates random data key for local encryption,
+// returns the plaintext data key and the data key wrapped under key specified by keyID.
+// Callers should only persist the wrapped key, and get plaintext back by UnwrapDataKey.
+func (sv *KeyService) GenerateDataKey(keyID string) (plaintext []byte, wrapped string, err error) {
+	return sv.GenerateDataKeyWithContext(keyID, nil)
+}
+
+// GenerateDataKeyWithContext is like GenerateDataKey, and binds context to the wrapped key.
+// The same context must be provided to UnwrapDataKeyWithContext.
+func (sv *KeyService) GenerateDataKeyWithContext(keyID string, context map[string]string) (plaintext []byte, wrapped string, err error) {
+	plaintext, err = randomBytes(dataKeySize)
+	if err != nil {
+		return
+	}
+
+	bs, err := sv.encrypt(plaintext, keyID, context, false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	wrapped = base64.RawURLEncoding.EncodeToString(bs)
+
+	return
+}
+
+// UnwrapDataKey returns plaintext of data key wrapped by GenerateDataKey
+func (sv *KeyService) UnwrapDataKey(wrapped string, keyID string) ([]byte, error) {
+	return sv.UnwrapDataKeyWithContext(wrapped, keyID, nil)
+}
+
+// UnwrapDataKeyWithContext returns plaintext of data key wrapped by GenerateDataKeyWithContext with the same context
+func (sv *KeyService) UnwrapDataKeyWithContext(wrapped string, keyID string, context map[string]string) ([]byte, error) {
+	plaintext, err := sv.DecryptWithContext(wrapped, keyID, context)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(plaintext) != dataKeySize {
+		return nil, ErrInvalidDataKey
+	}
+
+	return []byte(plaintext), nil
+}
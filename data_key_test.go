@@ -0,0 +1,70 @@
+package keyservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDataKey(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	plaintext, wrapped, err := sv.GenerateDataKey(_testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, dataKeySize, len(plaintext))
+	assert.NotEmpty(t, wrapped)
+
+	plaintext2, wrapped2, err := sv.GenerateDataKey(_testKeyId2)
+	require.NoError(t, err)
+	assert.NotEqual(t, plaintext, plaintext2)
+	assert.NotEqual(t, wrapped, wrapped2)
+
+	unwrapped, err := sv.UnwrapDataKey(wrapped, _testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, unwrapped)
+
+	// data key encrypts locally
+	text := []byte("hello,world!")
+	encrypted, err := AesGCMEncrypt(text, plaintext, nil)
+	require.NoError(t, err)
+	decrypted, err := AesGCMDecrypt(encrypted, unwrapped, nil)
+	require.NoError(t, err)
+	assert.Equal(t, text, decrypted)
+
+	// still unwrappable after rotation
+	_, err = sv.RotateKey(_testKeyId2)
+	require.NoError(t, err)
+	unwrapped, err = sv.UnwrapDataKey(wrapped, _testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, unwrapped)
+
+	_, err = sv.UnwrapDataKey(wrapped, _testKeyId1)
+	assert.Error(t, err)
+
+	// ordinary encrypted data is not a data key
+	encryptedStr, err := sv.Encrypt("hello", _testKeyId2)
+	require.NoError(t, err)
+	_, err = sv.UnwrapDataKey(encryptedStr, _testKeyId2)
+	assert.Equal(t, ErrInvalidDataKey, err)
+
+	_, _, err = sv.GenerateDataKey("key-not-exists-id")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestGenerateDataKeyWithContext(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	context := map[string]string{"tenant": "t1"}
+	plaintext, wrapped, err := sv.GenerateDataKeyWithContext(_testKeyId1, context)
+	require.NoError(t, err)
+
+	unwrapped, err := sv.UnwrapDataKeyWithContext(wrapped, _testKeyId1, context)
+	require.NoError(t, err)
+	assert.Equal(t, plaintext, unwrapped)
+
+	_, err = sv.UnwrapDataKeyWithContext(wrapped, _testKeyId1, map[string]string{"tenant": "t2"})
+	assert.Equal(t, ErrSignatureError, err)
+	_, err = sv.UnwrapDataKey(wrapped, _testKeyId1)
+	assert.Error(t, err)
+}
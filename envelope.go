@@ -0,0 +1,140 @@
+package keyservice
+
+import (
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+)
+
+// Encrypted data formats.
+// The legacy format has no format byte: sig(4 bytes)+version(2 bytes)+AES-128-CBC data.
+// Newer formats start with a format byte so that Decrypt can tell them apart.
+const (
+	// format(1 byte)+version(2 bytes)+nonce(12 bytes)+AES-256-GCM data+tag(16 bytes)
+	formatAESGCM byte = 0x01
+	// format(1 byte)+version(2 bytes)+synthetic nonce(12 bytes)+AES-256-GCM data+tag(16 bytes)
+	// The nonce is derived from HMAC-SHA256 of additional data and plaintext instead of random,
+	// so the same plaintext yields the same ciphertext.
+	formatDeterministic byte = 0x03
+)
+
+// envelope header size: format(1 byte)+version(2 bytes)
+const envelopeHeaderSize = 3
+
+func putEnvelopeHeader(bs []byte, format byte, version uint16) {
+	bs[0] = format
+	bs[1] = byte(version >> 8)
+	bs[2] = byte(version & 0xff)
+}
+
+func parseEnvelopeHeader(bs []byte) (format byte, version uint16, ok bool) {
+	if len(bs) < envelopeHeaderSize {
+		return
+	}
+	return bs[0], (uint16(bs[1]) << 8) | uint16(bs[2]), true
+}
+
+// additionalData returns data that authenticated along with the encrypted data,
+// it binds the envelope header, key id and encryption context to the ciphertext.
+// The encryption context is serialized in key order, each key and value is length prefixed.
+func additionalData(header []byte, keyID string, context map[string]string) []byte {
+	ad := make([]byte, 0, len(header)+len(keyID))
+	ad = append(ad, header...)
+	ad = append(ad, keyID...)
+
+	if len(context) == 0 {
+		return ad
+	}
+
+	names := make([]string, 0, len(context))
+	for name := range context {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	for _, name := range names {
+		for _, s := range []string{name, context[name]} {
+			n := binary.PutUvarint(buf, uint64(len(s)))
+			ad = append(ad, buf[:n]...)
+			ad = append(ad, s...)
+		}
+	}
+
+	return ad
+}
+
+// deriveKey derives data encryption key from key value and seed key
+func (sv *KeyService) deriveKey(value string) []byte {
+	mac := hmac.New(sha256.New, sv.seedKey)
+	mac.Write([]byte(value))
+	return mac.Sum(nil)
+}
+
+// seal encrypts content with AES-256-GCM envelope, deterministic mode uses synthetic nonce
+func (sv *KeyService) seal(content []byte, key *Key, keyID string, context map[string]string, deterministic bool) ([]byte, error) {
+	format := formatAESGCM
+	if deterministic {
+		format = formatDeterministic
+	}
+
+	header := make([]byte, envelopeHeaderSize)
+	putEnvelopeHeader(header, format, key.Version)
+
+	derivedKey := sv.deriveKey(key.Current().Value)
+	ad := additionalData(header, keyID, context)
+
+	if !deterministic {
+		cipherBytes, err := AesGCMEncrypt(content, derivedKey, ad)
+		if err != nil {
+			return nil, err
+		}
+		return append(header, cipherBytes...), nil
+	}
+
+	aead, err := newGCM(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := syntheticNonce(aead, derivedKey, ad, content)
+
+	return aead.Seal(append(header, nonce...), nonce, content, ad), nil
+}
+
+// syntheticNonce derives nonce from additional data and content,
+// uses a sub key of derived key so that it's independent of the encryption key.
+func syntheticNonce(aead cipher.AEAD, derivedKey []byte, ad []byte, content []byte) []byte {
+	subKey := hmac.New(sha256.New, derivedKey)
+	subKey.Write([]byte("deterministic-nonce"))
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	mac := hmac.New(sha256.New, subKey.Sum(nil))
+	mac.Write(buf[:binary.PutUvarint(buf, uint64(len(ad)))])
+	mac.Write(ad)
+	mac.Write(content)
+
+	return mac.Sum(nil)[:aead.NonceSize()]
+}
+
+// open decrypts data generated by seal
+func (sv *KeyService) open(data []byte, key *Key, keyID string, context map[string]string) ([]byte, error) {
+	format, version, ok := parseEnvelopeHeader(data)
+	if !ok || (format != formatAESGCM && format != formatDeterministic) {
+		return nil, ErrInvalidEncryptedData
+	}
+
+	keyValue := key.valueOf(version)
+	if keyValue == "" {
+		return nil, ErrInvalidEncryptedData
+	}
+
+	header := data[:envelopeHeaderSize]
+	text, err := AesGCMDecrypt(data[envelopeHeaderSize:], sv.deriveKey(keyValue), additionalData(header, keyID, context))
+	if err != nil {
+		return nil, ErrSignatureError
+	}
+
+	return text, nil
+}
@@ -0,0 +1,171 @@
+package keyservice
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+var errFF1InvalidInput = errors.New("ff1: invalid input")
+
+const (
+	ff1Rounds = 10
+	// radix^minLen >= 1000000
+	ff1MinLen = 6
+	ff1MaxLen = 128
+)
+
+// ff1 implements FF1 format-preserving encryption of NIST SP 800-38G for radix 10
+type ff1 struct {
+	block cipher.Block
+	radix int
+}
+
+func newFF1(key []byte) (*ff1, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &ff1{block: block, radix: 10}, nil
+}
+
+// num converts digits to number
+func (f *ff1) num(digits []byte) *big.Int {
+	n := new(big.Int)
+	r := big.NewInt(int64(f.radix))
+	for _, d := range digits {
+		n.Mul(n, r)
+		n.Add(n, big.NewInt(int64(d)))
+	}
+	return n
+}
+
+// str converts number to m digits
+func (f *ff1) str(n *big.Int, m int) []byte {
+	digits := make([]byte, m)
+	r := big.NewInt(int64(f.radix))
+	n = new(big.Int).Set(n)
+	mod := new(big.Int)
+	for i := m - 1; i >= 0; i-- {
+		n.DivMod(n, r, mod)
+		digits[i] = byte(mod.Int64())
+	}
+	return digits
+}
+
+// prf is CBC-MAC with zero IV
+func (f *ff1) prf(data []byte) []byte {
+	y := make([]byte, aes.BlockSize)
+	for i := 0; i < len(data); i += aes.BlockSize {
+		for j := 0; j < aes.BlockSize; j++ {
+			y[j] ^= data[i+j]
+		}
+		f.block.Encrypt(y, y)
+	}
+	return y
+}
+
+func (f *ff1) crypt(x []byte, tweak []byte, decrypt bool) ([]byte, error) {
+	n := len(x)
+	if n < ff1MinLen || n > ff1MaxLen {
+		return nil, errFF1InvalidInput
+	}
+	for _, d := range x {
+		if int(d) >= f.radix {
+			return nil, errFF1InvalidInput
+		}
+	}
+
+	t := len(tweak)
+	u := n / 2
+	v := n - u
+	a := append([]byte(nil), x[:u]...)
+	b := append([]byte(nil), x[u:]...)
+
+	// b = ceil(ceil(v*log2(radix))/8)
+	bl := (new(big.Int).Exp(big.NewInt(int64(f.radix)), big.NewInt(int64(v)), nil).BitLen() + 7) / 8
+	d := 4*((bl+3)/4) + 4
+
+	p := make([]byte, aes.BlockSize)
+	p[0], p[1], p[2] = 1, 2, 1
+	p[3] = byte(f.radix >> 16)
+	p[4] = byte(f.radix >> 8)
+	p[5] = byte(f.radix)
+	p[6] = 10
+	p[7] = byte(u)
+	binary.BigEndian.PutUint32(p[8:], uint32(n))
+	binary.BigEndian.PutUint32(p[12:], uint32(t))
+
+	qLen := t + bl + 1
+	qLen += (aes.BlockSize - qLen%aes.BlockSize) % aes.BlockSize
+	pq := make([]byte, aes.BlockSize+qLen)
+	copy(pq, p)
+	copy(pq[aes.BlockSize:], tweak)
+
+	radix := big.NewInt(int64(f.radix))
+	modU := new(big.Int).Exp(radix, big.NewInt(int64(u)), nil)
+	modV := new(big.Int).Exp(radix, big.NewInt(int64(v)), nil)
+
+	s := make([]byte, ((d+aes.BlockSize-1)/aes.BlockSize)*aes.BlockSize)
+	blk := make([]byte, aes.BlockSize)
+	y := new(big.Int)
+	c := new(big.Int)
+
+	for round := 0; round < ff1Rounds; round++ {
+		i := round
+		if decrypt {
+			i = ff1Rounds - 1 - round
+		}
+
+		// Q = T || [0]^((-t-b-1) mod 16) || [i] || [NUM(B)]^b, NUM(A) when decrypting
+		src := b
+		if decrypt {
+			src = a
+		}
+		q := pq[aes.BlockSize:]
+		for j := t; j < len(q); j++ {
+			q[j] = 0
+		}
+		q[len(q)-bl-1] = byte(i)
+		f.num(src).FillBytes(q[len(q)-bl:])
+
+		r := f.prf(pq)
+		copy(s, r)
+		for j := 1; j*aes.BlockSize < d; j++ {
+			copy(blk, r)
+			binary.BigEndian.PutUint32(blk[aes.BlockSize-4:], binary.BigEndian.Uint32(r[aes.BlockSize-4:])^uint32(j))
+			f.block.Encrypt(s[j*aes.BlockSize:], blk)
+		}
+		y.SetBytes(s[:d])
+
+		m, mod := u, modU
+		if i%2 == 1 {
+			m, mod = v, modV
+		}
+
+		if decrypt {
+			c.Sub(f.num(b), y)
+		} else {
+			c.Add(f.num(a), y)
+		}
+		c.Mod(c, mod)
+
+		if decrypt {
+			a, b = f.str(c, m), a
+		} else {
+			a, b = b, f.str(c, m)
+		}
+	}
+
+	return append(a, b...), nil
+}
+
+func (f *ff1) Encrypt(x []byte, tweak []byte) ([]byte, error) {
+	return f.crypt(x, tweak, false)
+}
+
+func (f *ff1) Decrypt(x []byte, tweak []byte) ([]byte, error) {
+	return f.crypt(x, tweak, true)
+}
@@ -0,0 +1,52 @@
+package keyservice
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func digitsOf(s string) []byte {
+	bs := make([]byte, len(s))
+	for i := range s {
+		bs[i] = s[i] - '0'
+	}
+	return bs
+}
+
+// NIST SP 800-38G FF1 samples of radix 10
+func TestFF1(t *testing.T) {
+	cases := []struct {
+		key, tweak, plaintext, ciphertext string
+	}{
+		{"2b7e151628aed2a6abf7158809cf4f3c", "", "0123456789", "2433477484"},
+		{"2b7e151628aed2a6abf7158809cf4f3c", "39383736353433323130", "0123456789", "6124200773"},
+		{"2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f", "", "0123456789", "2830668132"},
+		{"2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f", "39383736353433323130", "0123456789", "2496655549"},
+		{"2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f7f036d6f04fc6a94", "", "0123456789", "6657667009"},
+		{"2b7e151628aed2a6abf7158809cf4f3cef4359d8d580aa4f7f036d6f04fc6a94", "39383736353433323130", "0123456789", "1001623463"},
+	}
+
+	for _, c := range cases {
+		key, _ := hex.DecodeString(c.key)
+		tweak, _ := hex.DecodeString(c.tweak)
+		f, err := newFF1(key)
+		require.NoError(t, err)
+
+		ciphertext, err := f.Encrypt(digitsOf(c.plaintext), tweak)
+		require.NoError(t, err)
+		assert.Equal(t, digitsOf(c.ciphertext), ciphertext, "key=%s tweak=%s", c.key, c.tweak)
+
+		plaintext, err := f.Decrypt(ciphertext, tweak)
+		require.NoError(t, err)
+		assert.Equal(t, digitsOf(c.plaintext), plaintext)
+	}
+
+	f, _ := newFF1(make([]byte, 32))
+	_, err := f.Encrypt(digitsOf("12345"), nil)
+	assert.Equal(t, errFF1InvalidInput, err)
+	_, err = f.Encrypt([]byte{1, 2, 3, 4, 5, 10}, nil)
+	assert.Equal(t, errFF1InvalidInput, err)
+}
@@ -3,15 +3,20 @@ module github.com/techxmind/keyservice
 go 1.15
 
 require (
+	github.com/fsnotify/fsnotify v1.4.9
 	github.com/go-kit/kit v0.10.0
+	github.com/go-sql-driver/mysql v1.4.0
 	github.com/gogo/protobuf v1.3.2
 	github.com/gorilla/mux v1.8.0
+	github.com/lib/pq v1.10.0
+	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/metaverse/truss v0.2.1
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus/client_golang v1.10.0
 	github.com/stretchr/testify v1.6.1
 	github.com/techxmind/go-utils v0.1.1
 	github.com/techxmind/logger v0.1.0
+	go.etcd.io/bbolt v1.3.5
 	golang.org/x/sync v0.0.0-20201207232520-09787c993a3a
 	golang.org/x/sys v0.0.0-20210319071255-635bc2c9138d // indirect
 	google.golang.org/grpc v1.36.0
@@ -29,8 +29,11 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type EncryptRequest struct {
-	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
-	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	KeyId         string            `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Data          string            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Context       map[string]string `protobuf:"bytes,3,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RawData       []byte            `protobuf:"bytes,4,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
+	Deterministic bool              `protobuf:"varint,5,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 }
 
 func (m *EncryptRequest) Reset()         { *m = EncryptRequest{} }
@@ -80,6 +83,27 @@ func (m *EncryptRequest) GetData() string {
 	return ""
 }
 
+func (m *EncryptRequest) GetContext() map[string]string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *EncryptRequest) GetRawData() []byte {
+	if m != nil {
+		return m.RawData
+	}
+	return nil
+}
+
+func (m *EncryptRequest) GetDeterministic() bool {
+	if m != nil {
+		return m.Deterministic
+	}
+	return false
+}
+
 type EncryptBatchRequest struct {
 	Items []*EncryptRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
@@ -125,8 +149,11 @@ func (m *EncryptBatchRequest) GetItems() []*EncryptRequest {
 }
 
 type DecryptRequest struct {
-	KeyId  string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
-	Cipher string `protobuf:"bytes,2,opt,name=cipher,proto3" json:"cipher,omitempty"`
+	KeyId     string            `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Cipher    string            `protobuf:"bytes,2,opt,name=cipher,proto3" json:"cipher,omitempty"`
+	Context   map[string]string `protobuf:"bytes,3,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RawCipher []byte            `protobuf:"bytes,4,opt,name=raw_cipher,json=rawCipher,proto3" json:"raw_cipher,omitempty"`
+	Mask      string            `protobuf:"bytes,5,opt,name=mask,proto3" json:"mask,omitempty"`
 }
 
 func (m *DecryptRequest) Reset()         { *m = DecryptRequest{} }
@@ -176,6 +203,27 @@ func (m *DecryptRequest) GetCipher() string {
 	return ""
 }
 
+func (m *DecryptRequest) GetContext() map[string]string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+func (m *DecryptRequest) GetRawCipher() []byte {
+	if m != nil {
+		return m.RawCipher
+	}
+	return nil
+}
+
+func (m *DecryptRequest) GetMask() string {
+	if m != nil {
+		return m.Mask
+	}
+	return ""
+}
+
 type DecryptBatchRequest struct {
 	Items []*DecryptRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
@@ -221,9 +269,10 @@ func (m *DecryptBatchRequest) GetItems() []*DecryptRequest {
 }
 
 type Response struct {
-	Code   int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Msg    string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	Result string `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Code      int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg       string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result    string `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	RawResult []byte `protobuf:"bytes,4,opt,name=raw_result,json=rawResult,proto3" json:"raw_result,omitempty"`
 }
 
 func (m *Response) Reset()         { *m = Response{} }
@@ -280,6 +329,13 @@ func (m *Response) GetResult() string {
 	return ""
 }
 
+func (m *Response) GetRawResult() []byte {
+	if m != nil {
+		return m.RawResult
+	}
+	return nil
+}
+
 type BatchResponse struct {
 	Code    int32       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Msg     string      `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -444,21 +500,22 @@ func (m *KeyResponse) GetResult() map[string]string {
 	return nil
 }
 
-type Empty struct {
+type RotateRequest struct {
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 }
 
-func (m *Empty) Reset()         { *m = Empty{} }
-func (m *Empty) String() string { return proto.CompactTextString(m) }
-func (*Empty) ProtoMessage()    {}
-func (*Empty) Descriptor() ([]byte, []int) {
+func (m *RotateRequest) Reset()         { *m = RotateRequest{} }
+func (m *RotateRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateRequest) ProtoMessage()    {}
+func (*RotateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e0421ca30a026248, []int{8}
 }
-func (m *Empty) XXX_Unmarshal(b []byte) error {
+func (m *RotateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RotateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RotateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -468,796 +525,6944 @@ func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Empty) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Empty.Merge(m, src)
+func (m *RotateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateRequest.Merge(m, src)
 }
-func (m *Empty) XXX_Size() int {
+func (m *RotateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Empty) XXX_DiscardUnknown() {
-	xxx_messageInfo_Empty.DiscardUnknown(m)
+func (m *RotateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Empty proto.InternalMessageInfo
+var xxx_messageInfo_RotateRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*EncryptRequest)(nil), "EncryptRequest")
-	proto.RegisterType((*EncryptBatchRequest)(nil), "EncryptBatchRequest")
-	proto.RegisterType((*DecryptRequest)(nil), "DecryptRequest")
-	proto.RegisterType((*DecryptBatchRequest)(nil), "DecryptBatchRequest")
-	proto.RegisterType((*Response)(nil), "Response")
-	proto.RegisterType((*BatchResponse)(nil), "BatchResponse")
-	proto.RegisterType((*KeyRequest)(nil), "KeyRequest")
-	proto.RegisterType((*KeyResponse)(nil), "KeyResponse")
-	proto.RegisterMapType((map[string]string)(nil), "KeyResponse.ResultEntry")
-	proto.RegisterType((*Empty)(nil), "Empty")
+func (m *RotateRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("keyservice.proto", fileDescriptor_e0421ca30a026248) }
-
-var fileDescriptor_e0421ca30a026248 = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xde, 0x6c, 0x37, 0xcd, 0xee, 0xeb, 0x6e, 0x2d, 0xd3, 0xaa, 0xb1, 0x42, 0x58, 0x46, 0x16,
-	0x8a, 0x87, 0x44, 0x76, 0x2f, 0x5a, 0x05, 0x61, 0xb1, 0xa0, 0xec, 0x41, 0x89, 0x37, 0x2f, 0x4b,
-	0x9a, 0xbc, 0x4d, 0x43, 0xdb, 0x24, 0x66, 0x26, 0x85, 0x5c, 0x05, 0xef, 0x82, 0x7f, 0xc0, 0x9f,
-	0xe3, 0x71, 0xc1, 0x8b, 0x47, 0x69, 0xfd, 0x21, 0x32, 0x93, 0x09, 0x4d, 0x97, 0xb2, 0xb8, 0xb7,
-	0xf7, 0xe6, 0xbd, 0xf9, 0xbe, 0xef, 0xbd, 0xf9, 0x18, 0xe8, 0x4c, 0xb1, 0x60, 0x98, 0x2d, 0x22,
-	0x1f, 0xed, 0x34, 0x4b, 0x78, 0xd2, 0x1f, 0x85, 0x11, 0x9f, 0xe4, 0x63, 0xdb, 0x4f, 0xe6, 0xce,
-	0x1c, 0xb9, 0xb7, 0xc0, 0x8c, 0xa1, 0xc3, 0xb3, 0x9c, 0x31, 0x27, 0xc0, 0x2b, 0x9e, 0x21, 0x3a,
-	0x61, 0x92, 0x84, 0x33, 0xe4, 0x93, 0x28, 0x0b, 0x52, 0x2f, 0xe3, 0x85, 0xe3, 0xc5, 0x71, 0xc2,
-	0x3d, 0x1e, 0x25, 0x31, 0x53, 0x30, 0x8f, 0xcb, 0x1e, 0x47, 0x66, 0xe3, 0xfc, 0xca, 0xc1, 0x79,
-	0xca, 0x8b, 0xb2, 0x48, 0x5f, 0x42, 0x7b, 0x14, 0xfb, 0x59, 0x91, 0x72, 0x17, 0x3f, 0xe7, 0xc8,
-	0x38, 0xb9, 0x0f, 0xcd, 0x29, 0x16, 0x97, 0x51, 0x60, 0x6a, 0xc7, 0xda, 0xe0, 0xc0, 0xd5, 0xa7,
-	0x58, 0xbc, 0x0b, 0x08, 0x81, 0xbd, 0xc0, 0xe3, 0x9e, 0xb9, 0x2b, 0x0f, 0x65, 0x4c, 0x5f, 0x41,
-	0x57, 0x5d, 0x3e, 0xf7, 0xb8, 0x3f, 0xa9, 0x10, 0x4e, 0x40, 0x8f, 0x38, 0xce, 0x99, 0xa9, 0x1d,
-	0x37, 0x06, 0xad, 0xd3, 0x7b, 0xf6, 0x26, 0x83, 0x5b, 0x56, 0xe9, 0x6b, 0x68, 0xbf, 0xc1, 0xff,
-	0xa1, 0x7e, 0x00, 0x4d, 0x3f, 0x4a, 0x27, 0x98, 0x29, 0x72, 0x95, 0x09, 0x7a, 0x05, 0x70, 0x3b,
-	0xfd, 0x26, 0x4b, 0x45, 0xff, 0x16, 0xf6, 0x5d, 0x64, 0x69, 0x12, 0x33, 0x14, 0xc3, 0xf9, 0x49,
-	0x80, 0x92, 0x56, 0x77, 0x65, 0x4c, 0x3a, 0xd0, 0x98, 0xb3, 0x50, 0x51, 0x8a, 0x50, 0xe8, 0xc8,
-	0x90, 0xe5, 0x33, 0x6e, 0x36, 0x4a, 0x1d, 0x65, 0x46, 0x3f, 0xc1, 0x91, 0x12, 0x70, 0x27, 0xb8,
-	0x27, 0x60, 0x94, 0x00, 0xcc, 0x6c, 0x48, 0xa5, 0x07, 0x76, 0x85, 0xe0, 0x56, 0x15, 0x7a, 0x02,
-	0x70, 0x81, 0x45, 0x35, 0xda, 0x43, 0x30, 0xca, 0x05, 0x95, 0xc3, 0x1d, 0xb8, 0x4d, 0xb9, 0x21,
-	0x46, 0x7f, 0x68, 0xd0, 0x92, 0x7d, 0x77, 0x52, 0xf0, 0xac, 0x36, 0x90, 0x10, 0x60, 0xda, 0x35,
-	0x0c, 0x21, 0x26, 0x9f, 0xf1, 0x51, 0xcc, 0xb3, 0xa2, 0x1a, 0xb5, 0xff, 0x02, 0x5a, 0xb5, 0x63,
-	0x01, 0x39, 0xc5, 0x42, 0xbd, 0x96, 0x08, 0x49, 0x0f, 0xf4, 0x85, 0x37, 0xcb, 0x51, 0xd1, 0x94,
-	0xc9, 0x70, 0xf7, 0xb9, 0x46, 0x0d, 0xd0, 0x47, 0xc2, 0x78, 0xa7, 0x5f, 0x1b, 0x72, 0xa6, 0x8f,
-	0xa5, 0xd7, 0xc9, 0x10, 0x0c, 0xe5, 0x0f, 0x72, 0xd3, 0x29, 0xfd, 0xf5, 0x46, 0x68, 0xf7, 0xcb,
-	0xaf, 0xbf, 0xdf, 0x77, 0x8f, 0xe8, 0xbe, 0x83, 0x65, 0xcf, 0x50, 0x7b, 0x4a, 0xde, 0xc3, 0x61,
-	0xdd, 0x80, 0xa4, 0x67, 0x6f, 0xf1, 0x63, 0xbf, 0x6d, 0x6f, 0x3c, 0x0f, 0x7d, 0x24, 0xa1, 0xba,
-	0xb4, 0x5d, 0x41, 0x5d, 0x8e, 0x45, 0x5d, 0x00, 0x0e, 0xc1, 0x50, 0x6e, 0x21, 0x37, 0x7d, 0xb3,
-	0x5d, 0x4c, 0x80, 0x75, 0x31, 0x75, 0x3b, 0x92, 0x9e, 0xbd, 0xc5, 0x9d, 0xb7, 0x88, 0x51, 0x50,
-	0x6b, 0x31, 0x67, 0xb0, 0x77, 0x81, 0x05, 0x23, 0x2d, 0x7b, 0x6d, 0x81, 0xfe, 0x61, 0xfd, 0x8d,
-	0x68, 0x47, 0xde, 0x06, 0xaa, 0x3b, 0xe2, 0xf7, 0x10, 0x97, 0x06, 0xb0, 0xf7, 0x21, 0x8a, 0x43,
-	0xd2, 0xb4, 0xe5, 0xb6, 0xeb, 0xaa, 0x8f, 0x64, 0xb3, 0x41, 0x74, 0x27, 0x8d, 0xe2, 0xf0, 0xdc,
-	0xfc, 0xb9, 0xb4, 0xb4, 0xeb, 0xa5, 0xa5, 0xfd, 0x59, 0x5a, 0xda, 0xb7, 0x95, 0xb5, 0x73, 0xbd,
-	0xb2, 0x76, 0x7e, 0xaf, 0xac, 0x9d, 0x71, 0x53, 0xfe, 0x0d, 0x67, 0xff, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x54, 0xa3, 0x57, 0x6d, 0x93, 0x04, 0x00, 0x00,
+type KeyIdRequest struct {
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *KeyIdRequest) Reset()         { *m = KeyIdRequest{} }
+func (m *KeyIdRequest) String() string { return proto.CompactTextString(m) }
+func (*KeyIdRequest) ProtoMessage()    {}
+func (*KeyIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{9}
+}
+func (m *KeyIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyIdRequest.Merge(m, src)
+}
+func (m *KeyIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyIdRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_KeyIdRequest proto.InternalMessageInfo
 
-// KeyServiceClient is the client API for KeyService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type KeyServiceClient interface {
-	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*Response, error)
-	EncryptBatch(ctx context.Context, in *EncryptBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
-	Decrypt(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*Response, error)
-	DecryptBatch(ctx context.Context, in *DecryptBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
-	Keys(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*KeyResponse, error)
-	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+func (m *KeyIdRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
 }
 
-type keyServiceClient struct {
-	cc *grpc.ClientConn
+type CreateKeyRequest struct {
+	KeyId          string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	RotationPeriod int64  `protobuf:"varint,2,opt,name=rotation_period,json=rotationPeriod,proto3" json:"rotation_period,omitempty"`
+	Mode           string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-func NewKeyServiceClient(cc *grpc.ClientConn) KeyServiceClient {
-	return &keyServiceClient{cc}
+func (m *CreateKeyRequest) Reset()         { *m = CreateKeyRequest{} }
+func (m *CreateKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateKeyRequest) ProtoMessage()    {}
+func (*CreateKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{10}
 }
-
-func (c *keyServiceClient) Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/KeyService/Encrypt", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CreateKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateKeyRequest.Merge(m, src)
+}
+func (m *CreateKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateKeyRequest.DiscardUnknown(m)
 }
 
-func (c *keyServiceClient) EncryptBatch(ctx context.Context, in *EncryptBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
-	out := new(BatchResponse)
-	err := c.cc.Invoke(ctx, "/KeyService/EncryptBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateKeyRequest proto.InternalMessageInfo
+
+func (m *CreateKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *keyServiceClient) Decrypt(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/KeyService/Decrypt", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateKeyRequest) GetRotationPeriod() int64 {
+	if m != nil {
+		return m.RotationPeriod
 	}
-	return out, nil
+	return 0
 }
 
-func (c *keyServiceClient) DecryptBatch(ctx context.Context, in *DecryptBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
-	out := new(BatchResponse)
-	err := c.cc.Invoke(ctx, "/KeyService/DecryptBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateKeyRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	return out, nil
+	return ""
 }
 
-func (c *keyServiceClient) Keys(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*KeyResponse, error) {
-	out := new(KeyResponse)
-	err := c.cc.Invoke(ctx, "/KeyService/Keys", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ScheduleKeyDeletionRequest struct {
+	KeyId         string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PendingWindow int64  `protobuf:"varint,2,opt,name=pending_window,json=pendingWindow,proto3" json:"pending_window,omitempty"`
+}
+
+func (m *ScheduleKeyDeletionRequest) Reset()         { *m = ScheduleKeyDeletionRequest{} }
+func (m *ScheduleKeyDeletionRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduleKeyDeletionRequest) ProtoMessage()    {}
+func (*ScheduleKeyDeletionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{11}
+}
+func (m *ScheduleKeyDeletionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleKeyDeletionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleKeyDeletionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ScheduleKeyDeletionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleKeyDeletionRequest.Merge(m, src)
+}
+func (m *ScheduleKeyDeletionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleKeyDeletionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleKeyDeletionRequest.DiscardUnknown(m)
 }
 
-func (c *keyServiceClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/KeyService/Ping", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScheduleKeyDeletionRequest proto.InternalMessageInfo
+
+func (m *ScheduleKeyDeletionRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
-	return out, nil
+	return ""
 }
 
-// KeyServiceServer is the server API for KeyService service.
-type KeyServiceServer interface {
-	Encrypt(context.Context, *EncryptRequest) (*Response, error)
-	EncryptBatch(context.Context, *EncryptBatchRequest) (*BatchResponse, error)
-	Decrypt(context.Context, *DecryptRequest) (*Response, error)
-	DecryptBatch(context.Context, *DecryptBatchRequest) (*BatchResponse, error)
-	Keys(context.Context, *KeyRequest) (*KeyResponse, error)
-	Ping(context.Context, *Empty) (*Response, error)
+func (m *ScheduleKeyDeletionRequest) GetPendingWindow() int64 {
+	if m != nil {
+		return m.PendingWindow
+	}
+	return 0
 }
 
-// UnimplementedKeyServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedKeyServiceServer struct {
+type ListKeysRequest struct {
+	PageToken string `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 }
 
-func (*UnimplementedKeyServiceServer) Encrypt(ctx context.Context, req *EncryptRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
+func (m *ListKeysRequest) Reset()         { *m = ListKeysRequest{} }
+func (m *ListKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListKeysRequest) ProtoMessage()    {}
+func (*ListKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{12}
 }
-func (*UnimplementedKeyServiceServer) EncryptBatch(ctx context.Context, req *EncryptBatchRequest) (*BatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EncryptBatch not implemented")
+func (m *ListKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedKeyServiceServer) Decrypt(ctx context.Context, req *DecryptRequest) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Decrypt not implemented")
+func (m *ListKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedKeyServiceServer) DecryptBatch(ctx context.Context, req *DecryptBatchRequest) (*BatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DecryptBatch not implemented")
+func (m *ListKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListKeysRequest.Merge(m, src)
 }
-func (*UnimplementedKeyServiceServer) Keys(ctx context.Context, req *KeyRequest) (*KeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Keys not implemented")
+func (m *ListKeysRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedKeyServiceServer) Ping(ctx context.Context, req *Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+func (m *ListKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListKeysRequest.DiscardUnknown(m)
 }
 
-func RegisterKeyServiceServer(s *grpc.Server, srv KeyServiceServer) {
-	s.RegisterService(&_KeyService_serviceDesc, srv)
-}
+var xxx_messageInfo_ListKeysRequest proto.InternalMessageInfo
 
-func _KeyService_Encrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EncryptRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(KeyServiceServer).Encrypt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/KeyService/Encrypt",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyServiceServer).Encrypt(ctx, req.(*EncryptRequest))
+func (m *ListKeysRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _KeyService_EncryptBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EncryptBatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(KeyServiceServer).EncryptBatch(ctx, in)
+func (m *ListKeysRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/KeyService/EncryptBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyServiceServer).EncryptBatch(ctx, req.(*EncryptBatchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _KeyService_Decrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecryptRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(KeyServiceServer).Decrypt(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/KeyService/Decrypt",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyServiceServer).Decrypt(ctx, req.(*DecryptRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// 密钥版本元数据，不包含密钥
+type KeyVersionMeta struct {
+	Version   uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	State     string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	CreatedAt int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64  `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func _KeyService_DecryptBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecryptBatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(KeyServiceServer).DecryptBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/KeyService/DecryptBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyServiceServer).DecryptBatch(ctx, req.(*DecryptBatchRequest))
+func (m *KeyVersionMeta) Reset()         { *m = KeyVersionMeta{} }
+func (m *KeyVersionMeta) String() string { return proto.CompactTextString(m) }
+func (*KeyVersionMeta) ProtoMessage()    {}
+func (*KeyVersionMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{13}
+}
+func (m *KeyVersionMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyVersionMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyVersionMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *KeyVersionMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyVersionMeta.Merge(m, src)
+}
+func (m *KeyVersionMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyVersionMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyVersionMeta.DiscardUnknown(m)
 }
 
-func _KeyService_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KeyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(KeyServiceServer).Keys(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/KeyService/Keys",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyServiceServer).Keys(ctx, req.(*KeyRequest))
+var xxx_messageInfo_KeyVersionMeta proto.InternalMessageInfo
+
+func (m *KeyVersionMeta) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _KeyService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(KeyServiceServer).Ping(ctx, in)
+func (m *KeyVersionMeta) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/KeyService/Ping",
+	return ""
+}
+
+func (m *KeyVersionMeta) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyServiceServer).Ping(ctx, req.(*Empty))
+	return 0
+}
+
+func (m *KeyVersionMeta) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _KeyService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "KeyService",
-	HandlerType: (*KeyServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Encrypt",
-			Handler:    _KeyService_Encrypt_Handler,
-		},
-		{
-			MethodName: "EncryptBatch",
-			Handler:    _KeyService_EncryptBatch_Handler,
-		},
-		{
-			MethodName: "Decrypt",
-			Handler:    _KeyService_Decrypt_Handler,
-		},
-		{
-			MethodName: "DecryptBatch",
-			Handler:    _KeyService_DecryptBatch_Handler,
-		},
-		{
-			MethodName: "Keys",
-			Handler:    _KeyService_Keys_Handler,
-		},
-		{
-			MethodName: "Ping",
-			Handler:    _KeyService_Ping_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "keyservice.proto",
+// 密钥元数据，不包含密钥
+type KeyMeta struct {
+	KeyId          string            `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Version        uint32            `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	State          string            `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	RotationPeriod int64             `protobuf:"varint,4,opt,name=rotation_period,json=rotationPeriod,proto3" json:"rotation_period,omitempty"`
+	DeletionTime   int64             `protobuf:"varint,5,opt,name=deletion_time,json=deletionTime,proto3" json:"deletion_time,omitempty"`
+	Mode           string            `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	Versions       []*KeyVersionMeta `protobuf:"bytes,6,rep,name=versions,proto3" json:"versions,omitempty"`
 }
 
-func (m *EncryptRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *KeyMeta) Reset()         { *m = KeyMeta{} }
+func (m *KeyMeta) String() string { return proto.CompactTextString(m) }
+func (*KeyMeta) ProtoMessage()    {}
+func (*KeyMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{14}
+}
+func (m *KeyMeta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyMeta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *KeyMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyMeta.Merge(m, src)
+}
+func (m *KeyMeta) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyMeta.DiscardUnknown(m)
 }
 
-func (m *EncryptRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeyId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
-		i += copy(dAtA[i:], m.KeyId)
-	}
-	if len(m.Data) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Data)))
-		i += copy(dAtA[i:], m.Data)
+var xxx_messageInfo_KeyMeta proto.InternalMessageInfo
+
+func (m *KeyMeta) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
-	return i, nil
+	return ""
 }
 
-func (m *EncryptBatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *KeyMeta) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EncryptBatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *KeyMeta) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	return i, nil
+	return ""
 }
 
-func (m *DecryptRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *KeyMeta) GetRotationPeriod() int64 {
+	if m != nil {
+		return m.RotationPeriod
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DecryptRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeyId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
-		i += copy(dAtA[i:], m.KeyId)
-	}
-	if len(m.Cipher) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Cipher)))
-		i += copy(dAtA[i:], m.Cipher)
+func (m *KeyMeta) GetDeletionTime() int64 {
+	if m != nil {
+		return m.DeletionTime
 	}
-	return i, nil
+	return 0
 }
 
-func (m *DecryptBatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *KeyMeta) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DecryptBatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, msg := range m.Items {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *KeyMeta) GetVersions() []*KeyVersionMeta {
+	if m != nil {
+		return m.Versions
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type KeyMetaResponse struct {
+	Code   int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg    string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result *KeyMeta `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (m *Response) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Msg) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
-		i += copy(dAtA[i:], m.Msg)
-	}
-	if len(m.Result) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Result)))
-		i += copy(dAtA[i:], m.Result)
+func (m *KeyMetaResponse) Reset()         { *m = KeyMetaResponse{} }
+func (m *KeyMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*KeyMetaResponse) ProtoMessage()    {}
+func (*KeyMetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{15}
+}
+func (m *KeyMetaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyMetaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *KeyMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyMetaResponse.Merge(m, src)
+}
+func (m *KeyMetaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyMetaResponse.DiscardUnknown(m)
 }
 
-func (m *BatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_KeyMetaResponse proto.InternalMessageInfo
+
+func (m *KeyMetaResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Msg) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
-		i += copy(dAtA[i:], m.Msg)
-	}
-	if len(m.Results) > 0 {
-		for _, msg := range m.Results {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *KeyMetaResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	return i, nil
+	return ""
 }
 
-func (m *KeyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *KeyMetaResponse) GetResult() *KeyMeta {
+	if m != nil {
+		return m.Result
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *KeyRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.KeyIds) > 0 {
-		for _, s := range m.KeyIds {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+type ListKeysResponse struct {
+	Code          int32      `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg           string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result        []*KeyMeta `protobuf:"bytes,3,rep,name=result,proto3" json:"result,omitempty"`
+	NextPageToken string     `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListKeysResponse) Reset()         { *m = ListKeysResponse{} }
+func (m *ListKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListKeysResponse) ProtoMessage()    {}
+func (*ListKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{16}
+}
+func (m *ListKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ListKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListKeysResponse.Merge(m, src)
+}
+func (m *ListKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListKeysResponse.DiscardUnknown(m)
 }
 
-func (m *KeyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListKeysResponse proto.InternalMessageInfo
+
+func (m *ListKeysResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *KeyResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Code != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
-	}
-	if len(m.Msg) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
-		i += copy(dAtA[i:], m.Msg)
+func (m *ListKeysResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	if len(m.Result) > 0 {
-		for k, _ := range m.Result {
-			dAtA[i] = 0x1a
-			i++
-			v := m.Result[k]
-			mapSize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
-			i = encodeVarintKeyservice(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintKeyservice(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintKeyservice(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+	return ""
+}
+
+func (m *ListKeysResponse) GetResult() []*KeyMeta {
+	if m != nil {
+		return m.Result
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Empty) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ListKeysResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type GenerateDataKeyRequest struct {
+	KeyId   string            `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Context map[string]string `protobuf:"bytes,2,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func encodeVarintKeyservice(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+func (m *GenerateDataKeyRequest) Reset()         { *m = GenerateDataKeyRequest{} }
+func (m *GenerateDataKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateDataKeyRequest) ProtoMessage()    {}
+func (*GenerateDataKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{17}
 }
-func (m *EncryptRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.KeyId)
-	if l > 0 {
-		n += 1 + l + sovKeyservice(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovKeyservice(uint64(l))
-	}
-	return n
+func (m *GenerateDataKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EncryptBatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovKeyservice(uint64(l))
+func (m *GenerateDataKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenerateDataKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *GenerateDataKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateDataKeyRequest.Merge(m, src)
+}
+func (m *GenerateDataKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenerateDataKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateDataKeyRequest.DiscardUnknown(m)
 }
 
-func (m *DecryptRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_GenerateDataKeyRequest proto.InternalMessageInfo
+
+func (m *GenerateDataKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
-	var l int
-	_ = l
-	l = len(m.KeyId)
-	if l > 0 {
-		n += 1 + l + sovKeyservice(uint64(l))
+	return ""
+}
+
+func (m *GenerateDataKeyRequest) GetContext() map[string]string {
+	if m != nil {
+		return m.Context
 	}
-	l = len(m.Cipher)
-	if l > 0 {
-		n += 1 + l + sovKeyservice(uint64(l))
+	return nil
+}
+
+type UnwrapDataKeyRequest struct {
+	KeyId   string            `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Wrapped string            `protobuf:"bytes,2,opt,name=wrapped,proto3" json:"wrapped,omitempty"`
+	Context map[string]string `protobuf:"bytes,3,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *UnwrapDataKeyRequest) Reset()         { *m = UnwrapDataKeyRequest{} }
+func (m *UnwrapDataKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*UnwrapDataKeyRequest) ProtoMessage()    {}
+func (*UnwrapDataKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{18}
+}
+func (m *UnwrapDataKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnwrapDataKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnwrapDataKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *UnwrapDataKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwrapDataKeyRequest.Merge(m, src)
+}
+func (m *UnwrapDataKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnwrapDataKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwrapDataKeyRequest.DiscardUnknown(m)
 }
 
-func (m *DecryptBatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_UnwrapDataKeyRequest proto.InternalMessageInfo
+
+func (m *UnwrapDataKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovKeyservice(uint64(l))
+	return ""
+}
+
+func (m *UnwrapDataKeyRequest) GetWrapped() string {
+	if m != nil {
+		return m.Wrapped
+	}
+	return ""
+}
+
+func (m *UnwrapDataKeyRequest) GetContext() map[string]string {
+	if m != nil {
+		return m.Context
+	}
+	return nil
+}
+
+type DataKeyResponse struct {
+	Code      int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg       string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Plaintext []byte `protobuf:"bytes,3,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	Wrapped   string `protobuf:"bytes,4,opt,name=wrapped,proto3" json:"wrapped,omitempty"`
+}
+
+func (m *DataKeyResponse) Reset()         { *m = DataKeyResponse{} }
+func (m *DataKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*DataKeyResponse) ProtoMessage()    {}
+func (*DataKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{19}
+}
+func (m *DataKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DataKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataKeyResponse.Merge(m, src)
+}
+func (m *DataKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataKeyResponse.DiscardUnknown(m)
 }
 
-func (m *Response) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DataKeyResponse proto.InternalMessageInfo
+
+func (m *DataKeyResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovKeyservice(uint64(m.Code))
+	return 0
+}
+
+func (m *DataKeyResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovKeyservice(uint64(l))
+	return ""
+}
+
+func (m *DataKeyResponse) GetPlaintext() []byte {
+	if m != nil {
+		return m.Plaintext
 	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovKeyservice(uint64(l))
+	return nil
+}
+
+func (m *DataKeyResponse) GetWrapped() string {
+	if m != nil {
+		return m.Wrapped
 	}
-	return n
+	return ""
 }
 
-func (m *BatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type BlindIndexRequest struct {
+	KeyId       string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Value       string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	AllVersions bool   `protobuf:"varint,3,opt,name=all_versions,json=allVersions,proto3" json:"all_versions,omitempty"`
+}
+
+func (m *BlindIndexRequest) Reset()         { *m = BlindIndexRequest{} }
+func (m *BlindIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*BlindIndexRequest) ProtoMessage()    {}
+func (*BlindIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{20}
+}
+func (m *BlindIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlindIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlindIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovKeyservice(uint64(m.Code))
+}
+func (m *BlindIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlindIndexRequest.Merge(m, src)
+}
+func (m *BlindIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlindIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlindIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlindIndexRequest proto.InternalMessageInfo
+
+func (m *BlindIndexRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovKeyservice(uint64(l))
+	return ""
+}
+
+func (m *BlindIndexRequest) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovKeyservice(uint64(l))
+	return ""
+}
+
+func (m *BlindIndexRequest) GetAllVersions() bool {
+	if m != nil {
+		return m.AllVersions
+	}
+	return false
+}
+
+type BlindIndexBatchRequest struct {
+	Items []*BlindIndexRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (m *BlindIndexBatchRequest) Reset()         { *m = BlindIndexBatchRequest{} }
+func (m *BlindIndexBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BlindIndexBatchRequest) ProtoMessage()    {}
+func (*BlindIndexBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{21}
+}
+func (m *BlindIndexBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlindIndexBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlindIndexBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BlindIndexBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlindIndexBatchRequest.Merge(m, src)
+}
+func (m *BlindIndexBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlindIndexBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlindIndexBatchRequest.DiscardUnknown(m)
 }
 
-func (m *KeyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BlindIndexBatchRequest proto.InternalMessageInfo
+
+func (m *BlindIndexBatchRequest) GetItems() []*BlindIndexRequest {
+	if m != nil {
+		return m.Items
 	}
-	var l int
-	_ = l
-	if len(m.KeyIds) > 0 {
-		for _, s := range m.KeyIds {
-			l = len(s)
-			n += 1 + l + sovKeyservice(uint64(l))
+	return nil
+}
+
+type BlindIndexResponse struct {
+	Code    int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg     string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result  string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Indexes []string `protobuf:"bytes,4,rep,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (m *BlindIndexResponse) Reset()         { *m = BlindIndexResponse{} }
+func (m *BlindIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*BlindIndexResponse) ProtoMessage()    {}
+func (*BlindIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{22}
+}
+func (m *BlindIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlindIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlindIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BlindIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlindIndexResponse.Merge(m, src)
+}
+func (m *BlindIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlindIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlindIndexResponse.DiscardUnknown(m)
 }
 
-func (m *KeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BlindIndexResponse proto.InternalMessageInfo
+
+func (m *BlindIndexResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
 	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovKeyservice(uint64(m.Code))
+	return 0
+}
+
+func (m *BlindIndexResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovKeyservice(uint64(l))
+	return ""
+}
+
+func (m *BlindIndexResponse) GetResult() string {
+	if m != nil {
+		return m.Result
 	}
-	if len(m.Result) > 0 {
-		for k, v := range m.Result {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
-			n += mapEntrySize + 1 + sovKeyservice(uint64(mapEntrySize))
+	return ""
+}
+
+func (m *BlindIndexResponse) GetIndexes() []string {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type BlindIndexBatchResponse struct {
+	Code    int32                 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg     string                `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Results []*BlindIndexResponse `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *BlindIndexBatchResponse) Reset()         { *m = BlindIndexBatchResponse{} }
+func (m *BlindIndexBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*BlindIndexBatchResponse) ProtoMessage()    {}
+func (*BlindIndexBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{23}
+}
+func (m *BlindIndexBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlindIndexBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlindIndexBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlindIndexBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlindIndexBatchResponse.Merge(m, src)
+}
+func (m *BlindIndexBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlindIndexBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlindIndexBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlindIndexBatchResponse proto.InternalMessageInfo
+
+func (m *BlindIndexBatchResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *BlindIndexBatchResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *BlindIndexBatchResponse) GetResults() []*BlindIndexResponse {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type TokenizeRequest struct {
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *TokenizeRequest) Reset()         { *m = TokenizeRequest{} }
+func (m *TokenizeRequest) String() string { return proto.CompactTextString(m) }
+func (*TokenizeRequest) ProtoMessage()    {}
+func (*TokenizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{24}
+}
+func (m *TokenizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeRequest.Merge(m, src)
+}
+func (m *TokenizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeRequest proto.InternalMessageInfo
+
+func (m *TokenizeRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *TokenizeRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type TokenizeResponse struct {
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Msg     string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Result  string `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Version uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *TokenizeResponse) Reset()         { *m = TokenizeResponse{} }
+func (m *TokenizeResponse) String() string { return proto.CompactTextString(m) }
+func (*TokenizeResponse) ProtoMessage()    {}
+func (*TokenizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{25}
+}
+func (m *TokenizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeResponse.Merge(m, src)
+}
+func (m *TokenizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeResponse proto.InternalMessageInfo
+
+func (m *TokenizeResponse) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *TokenizeResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *TokenizeResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *TokenizeResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type DetokenizeRequest struct {
+	KeyId   string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Token   string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Version uint32 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *DetokenizeRequest) Reset()         { *m = DetokenizeRequest{} }
+func (m *DetokenizeRequest) String() string { return proto.CompactTextString(m) }
+func (*DetokenizeRequest) ProtoMessage()    {}
+func (*DetokenizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{26}
+}
+func (m *DetokenizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DetokenizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DetokenizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DetokenizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetokenizeRequest.Merge(m, src)
+}
+func (m *DetokenizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DetokenizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetokenizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetokenizeRequest proto.InternalMessageInfo
+
+func (m *DetokenizeRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *DetokenizeRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *DetokenizeRequest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0421ca30a026248, []int{27}
+}
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EncryptRequest)(nil), "EncryptRequest")
+	proto.RegisterMapType((map[string]string)(nil), "EncryptRequest.ContextEntry")
+	proto.RegisterType((*EncryptBatchRequest)(nil), "EncryptBatchRequest")
+	proto.RegisterType((*DecryptRequest)(nil), "DecryptRequest")
+	proto.RegisterMapType((map[string]string)(nil), "DecryptRequest.ContextEntry")
+	proto.RegisterType((*DecryptBatchRequest)(nil), "DecryptBatchRequest")
+	proto.RegisterType((*Response)(nil), "Response")
+	proto.RegisterType((*BatchResponse)(nil), "BatchResponse")
+	proto.RegisterType((*KeyRequest)(nil), "KeyRequest")
+	proto.RegisterType((*KeyResponse)(nil), "KeyResponse")
+	proto.RegisterMapType((map[string]string)(nil), "KeyResponse.ResultEntry")
+	proto.RegisterType((*RotateRequest)(nil), "RotateRequest")
+	proto.RegisterType((*KeyIdRequest)(nil), "KeyIdRequest")
+	proto.RegisterType((*CreateKeyRequest)(nil), "CreateKeyRequest")
+	proto.RegisterType((*ScheduleKeyDeletionRequest)(nil), "ScheduleKeyDeletionRequest")
+	proto.RegisterType((*ListKeysRequest)(nil), "ListKeysRequest")
+	proto.RegisterType((*KeyVersionMeta)(nil), "KeyVersionMeta")
+	proto.RegisterType((*KeyMeta)(nil), "KeyMeta")
+	proto.RegisterType((*KeyMetaResponse)(nil), "KeyMetaResponse")
+	proto.RegisterType((*ListKeysResponse)(nil), "ListKeysResponse")
+	proto.RegisterType((*GenerateDataKeyRequest)(nil), "GenerateDataKeyRequest")
+	proto.RegisterMapType((map[string]string)(nil), "GenerateDataKeyRequest.ContextEntry")
+	proto.RegisterType((*UnwrapDataKeyRequest)(nil), "UnwrapDataKeyRequest")
+	proto.RegisterMapType((map[string]string)(nil), "UnwrapDataKeyRequest.ContextEntry")
+	proto.RegisterType((*DataKeyResponse)(nil), "DataKeyResponse")
+	proto.RegisterType((*BlindIndexRequest)(nil), "BlindIndexRequest")
+	proto.RegisterType((*BlindIndexBatchRequest)(nil), "BlindIndexBatchRequest")
+	proto.RegisterType((*BlindIndexResponse)(nil), "BlindIndexResponse")
+	proto.RegisterType((*BlindIndexBatchResponse)(nil), "BlindIndexBatchResponse")
+	proto.RegisterType((*TokenizeRequest)(nil), "TokenizeRequest")
+	proto.RegisterType((*TokenizeResponse)(nil), "TokenizeResponse")
+	proto.RegisterType((*DetokenizeRequest)(nil), "DetokenizeRequest")
+	proto.RegisterType((*Empty)(nil), "Empty")
+}
+
+func init() { proto.RegisterFile("keyservice.proto", fileDescriptor_e0421ca30a026248) }
+
+var fileDescriptor_e0421ca30a026248 = []byte{
+	// 1531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x0e, 0x2d, 0xc9, 0x92, 0x46, 0x9f, 0x5e, 0x3b, 0xb6, 0x22, 0x27, 0x86, 0xb3, 0x89, 0xf3,
+	0x1a, 0xef, 0x8b, 0x77, 0x55, 0x24, 0x40, 0x3f, 0x8c, 0x20, 0x40, 0x6c, 0xb9, 0x6d, 0xe0, 0xa6,
+	0x0d, 0x98, 0x8f, 0xa2, 0xe9, 0x41, 0xa0, 0xc5, 0x89, 0xbc, 0xb5, 0x44, 0xaa, 0xe4, 0xca, 0x8e,
+	0x72, 0xe8, 0x21, 0x40, 0xef, 0x05, 0xfa, 0x07, 0x7a, 0xef, 0xaf, 0xe8, 0xad, 0xc7, 0x00, 0xbd,
+	0xf4, 0xd6, 0x22, 0x29, 0x7a, 0xee, 0x4f, 0x28, 0x76, 0xb9, 0x34, 0x29, 0x9a, 0xae, 0xad, 0x24,
+	0xbd, 0x71, 0x67, 0x77, 0x9e, 0x79, 0x66, 0x66, 0x77, 0x66, 0x24, 0xa8, 0xef, 0xe3, 0xd8, 0x47,
+	0xef, 0x80, 0x77, 0x91, 0x0d, 0x3d, 0x57, 0xb8, 0xcd, 0xed, 0x1e, 0x17, 0x7b, 0xa3, 0x5d, 0xd6,
+	0x75, 0x07, 0xad, 0x01, 0x0a, 0xeb, 0x00, 0x3d, 0x1f, 0x5b, 0xc2, 0x1b, 0xf9, 0x7e, 0xcb, 0xc6,
+	0x27, 0xc2, 0x43, 0x6c, 0xf5, 0x5c, 0xb7, 0xd7, 0x47, 0xb1, 0xc7, 0x3d, 0x7b, 0x68, 0x79, 0x62,
+	0xdc, 0xb2, 0x1c, 0xc7, 0x15, 0x96, 0xe0, 0xae, 0xe3, 0x6b, 0x98, 0xe5, 0xe0, 0x4c, 0x4b, 0xad,
+	0x76, 0x47, 0x4f, 0x5a, 0x38, 0x18, 0x8a, 0x71, 0xb0, 0x49, 0xff, 0x32, 0xa0, 0xba, 0xed, 0x74,
+	0xbd, 0xf1, 0x50, 0x98, 0xf8, 0xf5, 0x08, 0x7d, 0x41, 0xce, 0xc3, 0xec, 0x3e, 0x8e, 0x3b, 0xdc,
+	0x6e, 0x18, 0xab, 0xc6, 0x7a, 0xd1, 0xcc, 0xed, 0xe3, 0xf8, 0x8e, 0x4d, 0x08, 0x64, 0x6d, 0x4b,
+	0x58, 0x8d, 0x19, 0x25, 0x54, 0xdf, 0xe4, 0x5d, 0xc8, 0x77, 0x5d, 0x47, 0xe0, 0x53, 0xd1, 0xc8,
+	0xac, 0x66, 0xd6, 0x4b, 0xd7, 0x2f, 0xb2, 0x49, 0x30, 0xb6, 0x15, 0x6c, 0x6f, 0x3b, 0xc2, 0x1b,
+	0x9b, 0xe1, 0x61, 0x72, 0x01, 0x0a, 0x9e, 0x75, 0xd8, 0x51, 0x78, 0xd9, 0x55, 0x63, 0xbd, 0x6c,
+	0xe6, 0x3d, 0xeb, 0xb0, 0x2d, 0x21, 0xaf, 0x42, 0xc5, 0x46, 0x81, 0xde, 0x80, 0x3b, 0xdc, 0x17,
+	0xbc, 0xdb, 0xc8, 0xad, 0x1a, 0xeb, 0x05, 0x73, 0x52, 0xd8, 0xdc, 0x80, 0x72, 0x1c, 0x99, 0xd4,
+	0x21, 0xb3, 0x8f, 0x63, 0x4d, 0x58, 0x7e, 0x92, 0x05, 0xc8, 0x1d, 0x58, 0xfd, 0x11, 0x6a, 0xbe,
+	0xc1, 0x62, 0x63, 0xe6, 0x7d, 0x83, 0xde, 0x84, 0x79, 0x4d, 0x72, 0xd3, 0x12, 0xdd, 0xbd, 0xd0,
+	0xed, 0x35, 0xc8, 0x71, 0x81, 0x03, 0xbf, 0x61, 0x28, 0x4f, 0x6a, 0x09, 0x4f, 0xcc, 0x60, 0x97,
+	0xfe, 0x69, 0x40, 0xb5, 0x8d, 0x67, 0x09, 0xd8, 0x22, 0xcc, 0x76, 0xf9, 0x70, 0x0f, 0x3d, 0x4d,
+	0x41, 0xaf, 0xd2, 0x82, 0xd6, 0xc6, 0x33, 0x04, 0xed, 0x12, 0x80, 0x0c, 0x9a, 0xc6, 0x0c, 0xc2,
+	0x56, 0xf4, 0xac, 0xc3, 0xad, 0x00, 0x96, 0x40, 0x76, 0x60, 0xf9, 0xfb, 0x2a, 0x5e, 0x45, 0x53,
+	0x7d, 0xbf, 0x69, 0x98, 0xda, 0x78, 0x86, 0x30, 0xb5, 0x31, 0x2d, 0x4c, 0x3d, 0x28, 0x98, 0xe8,
+	0x0f, 0x5d, 0xc7, 0x47, 0xc9, 0xac, 0xeb, 0xda, 0xa8, 0xcc, 0xe6, 0x4c, 0xf5, 0x2d, 0x99, 0x0c,
+	0xfc, 0x9e, 0xb6, 0x2a, 0x3f, 0x65, 0xb8, 0x3c, 0xf4, 0x47, 0x7d, 0x19, 0x15, 0x15, 0xae, 0x60,
+	0x15, 0xba, 0xad, 0xf7, 0x22, 0xb7, 0x4d, 0x25, 0xa0, 0x8f, 0xa1, 0xa2, 0xf9, 0x4d, 0x65, 0xed,
+	0x0a, 0xe4, 0x03, 0x44, 0x5f, 0x27, 0xa1, 0xc8, 0x42, 0x04, 0x33, 0xdc, 0xa1, 0x6b, 0x00, 0x3b,
+	0x38, 0x0e, 0x3d, 0x5f, 0x82, 0x7c, 0x90, 0xe6, 0xc0, 0xf7, 0xa2, 0x39, 0xab, 0xf2, 0xec, 0xd3,
+	0x1f, 0x0c, 0x28, 0xa9, 0x73, 0x53, 0x31, 0x78, 0x27, 0xe6, 0xaf, 0x24, 0xd0, 0x60, 0x31, 0x0c,
+	0x16, 0x78, 0x17, 0xdc, 0x00, 0x7d, 0xae, 0xf9, 0x01, 0x94, 0x62, 0xe2, 0xa9, 0x92, 0x79, 0x0d,
+	0x2a, 0xa6, 0x2c, 0x0b, 0xf8, 0xcf, 0x77, 0x96, 0xae, 0x41, 0x79, 0x47, 0x7e, 0x9c, 0x72, 0xec,
+	0x09, 0xd4, 0xb7, 0x3c, 0xb4, 0x04, 0xc6, 0xc2, 0x73, 0xc2, 0x2b, 0xf8, 0x0f, 0xd4, 0x3c, 0x5d,
+	0x90, 0x3a, 0x43, 0xf4, 0xb8, 0x6b, 0x2b, 0x76, 0x19, 0xb3, 0x1a, 0x8a, 0xef, 0x29, 0xa9, 0xba,
+	0xbf, 0x32, 0x6a, 0x19, 0x7d, 0x7f, 0x5d, 0x1b, 0xe9, 0x63, 0x68, 0xde, 0xef, 0xee, 0xa1, 0x3d,
+	0xea, 0x4b, 0x4b, 0x6d, 0xec, 0xa3, 0x54, 0x38, 0xc5, 0xe2, 0x1a, 0x54, 0x87, 0xe8, 0xd8, 0xdc,
+	0xe9, 0x75, 0x0e, 0xb9, 0x63, 0xbb, 0x87, 0xda, 0x60, 0x45, 0x4b, 0x3f, 0x57, 0x42, 0x7a, 0x17,
+	0x6a, 0x9f, 0x70, 0x5f, 0xec, 0xe0, 0xd8, 0x0f, 0x01, 0x2f, 0x01, 0x0c, 0xad, 0x1e, 0x76, 0x84,
+	0xbb, 0x8f, 0x8e, 0x06, 0x2d, 0x4a, 0xc9, 0x03, 0x29, 0x20, 0xcb, 0xa0, 0x16, 0x1d, 0x9f, 0x3f,
+	0x0b, 0x42, 0x9c, 0x33, 0x0b, 0x52, 0x70, 0x9f, 0x3f, 0x43, 0xfa, 0x0d, 0x54, 0x77, 0x70, 0xfc,
+	0x08, 0x3d, 0x9f, 0xbb, 0xce, 0x5d, 0x14, 0x16, 0x69, 0x40, 0xfe, 0x20, 0x58, 0x2a, 0xa8, 0x8a,
+	0x19, 0x2e, 0x65, 0x9e, 0x7c, 0x99, 0x8c, 0x30, 0x4f, 0x6a, 0x21, 0xad, 0x77, 0x55, 0x50, 0xed,
+	0x8e, 0x15, 0x3c, 0x82, 0x8c, 0x59, 0xd4, 0x92, 0xdb, 0x8a, 0xdc, 0x68, 0x68, 0x87, 0xdb, 0xd9,
+	0x60, 0x5b, 0x4b, 0x6e, 0x0b, 0xfa, 0x9b, 0x01, 0xf9, 0x1d, 0x1c, 0x2b, 0xcb, 0x27, 0x04, 0x26,
+	0x46, 0x68, 0xe6, 0x04, 0x42, 0x99, 0x38, 0xa1, 0x94, 0xd4, 0x65, 0x53, 0x53, 0x77, 0x45, 0xd6,
+	0xec, 0x20, 0x37, 0x1d, 0xc1, 0x07, 0xa8, 0x6a, 0x50, 0xc6, 0x2c, 0x87, 0xc2, 0x07, 0x7c, 0x80,
+	0x47, 0xf9, 0xcd, 0x47, 0xf9, 0x25, 0xff, 0x83, 0x82, 0xa6, 0xe0, 0x37, 0x66, 0x75, 0x3d, 0x99,
+	0x8c, 0xa2, 0x79, 0x74, 0x80, 0x7e, 0x01, 0x35, 0xed, 0xe0, 0x94, 0x2f, 0x6d, 0x75, 0xa2, 0xb2,
+	0x94, 0xae, 0x17, 0x58, 0x88, 0xa3, 0xe5, 0xf4, 0xb9, 0x01, 0xf5, 0xe8, 0x32, 0xbc, 0x36, 0x78,
+	0x26, 0x0d, 0x9c, 0x5c, 0x83, 0x9a, 0x83, 0x4f, 0x45, 0x27, 0x76, 0xb5, 0xb2, 0x4a, 0xbf, 0x22,
+	0xc5, 0xf7, 0xc2, 0xeb, 0x45, 0x7f, 0x34, 0x60, 0xf1, 0x23, 0x74, 0xd0, 0xb3, 0x04, 0xca, 0x56,
+	0x78, 0xfa, 0xdb, 0xba, 0x15, 0x75, 0x92, 0x19, 0x65, 0xfc, 0x2a, 0x4b, 0x07, 0x48, 0xef, 0x28,
+	0x6f, 0xd4, 0x1e, 0x7e, 0x32, 0x60, 0xe1, 0xa1, 0x73, 0xe8, 0x59, 0xc3, 0xb3, 0x71, 0x6d, 0x40,
+	0x5e, 0x1e, 0x1e, 0xa2, 0xad, 0xb1, 0xc2, 0x25, 0xb9, 0x99, 0xec, 0x87, 0x94, 0xa5, 0x01, 0xff,
+	0x0b, 0x3e, 0xb8, 0x50, 0x3b, 0xb2, 0x31, 0x55, 0xd2, 0x2f, 0x42, 0x71, 0xd8, 0xb7, 0x78, 0x48,
+	0x5a, 0xb5, 0xa4, 0x23, 0x41, 0xdc, 0xd5, 0xec, 0x84, 0xab, 0xb4, 0x0b, 0x73, 0x9b, 0x7d, 0xee,
+	0xd8, 0x77, 0x1c, 0x1b, 0x9f, 0x9e, 0x12, 0xb0, 0x54, 0xda, 0xe4, 0x32, 0x94, 0xad, 0x7e, 0xbf,
+	0x73, 0xf4, 0x6a, 0x32, 0x6a, 0x3a, 0x2a, 0x59, 0xfd, 0xfe, 0xa3, 0xf0, 0x9d, 0x6c, 0xc2, 0x62,
+	0x64, 0x64, 0xa2, 0x77, 0xaf, 0x4f, 0xf6, 0x6e, 0xc2, 0x8e, 0x91, 0x09, 0xdb, 0x77, 0x1f, 0x48,
+	0x7c, 0xef, 0xad, 0x34, 0xf2, 0x06, 0xe4, 0xb9, 0x84, 0x43, 0xbf, 0x91, 0x55, 0xfd, 0x33, 0x5c,
+	0x52, 0x07, 0x96, 0x8e, 0x31, 0x9e, 0xca, 0xe4, 0xff, 0x93, 0xdd, 0x7c, 0x9e, 0x1d, 0xa7, 0x1f,
+	0xf5, 0xf5, 0x5b, 0x50, 0x53, 0x4f, 0x8e, 0x3f, 0xc3, 0xd7, 0x49, 0x02, 0xfd, 0x0a, 0xea, 0x91,
+	0xfe, 0xdb, 0x8a, 0x4d, 0x58, 0x9a, 0xb3, 0x13, 0xa5, 0x99, 0x3e, 0x86, 0xb9, 0x36, 0x8a, 0x33,
+	0xb3, 0x0d, 0xea, 0x8b, 0x66, 0xab, 0x16, 0x71, 0xec, 0xcc, 0x24, 0x76, 0x1e, 0x72, 0xdb, 0xf2,
+	0xb7, 0xc0, 0xf5, 0x6f, 0x4b, 0x6a, 0xd2, 0xb9, 0x1f, 0xfc, 0xfc, 0x20, 0x1b, 0x90, 0xd7, 0xc3,
+	0x2f, 0x49, 0x8e, 0xc1, 0xcd, 0x68, 0x4e, 0xa2, 0xf3, 0xcf, 0x7f, 0xf9, 0xe3, 0xfb, 0x99, 0x0a,
+	0x2d, 0xb4, 0x30, 0x38, 0xb3, 0x61, 0xfc, 0x97, 0x7c, 0x06, 0xe5, 0xf8, 0x74, 0x4d, 0x16, 0x58,
+	0xca, 0xb0, 0xdd, 0xac, 0xb2, 0x89, 0x34, 0xd3, 0x0b, 0x0a, 0x6a, 0x9e, 0x56, 0x43, 0xa8, 0xce,
+	0xae, 0xdc, 0x97, 0x80, 0x1b, 0x90, 0xd7, 0x23, 0x26, 0x49, 0x0e, 0x9b, 0xe9, 0x64, 0x6c, 0x8c,
+	0x93, 0x89, 0xcf, 0xb0, 0x64, 0x81, 0xb5, 0x71, 0x1a, 0x32, 0x36, 0x26, 0xc8, 0xdc, 0x80, 0xac,
+	0xec, 0x11, 0xa4, 0xc4, 0xa2, 0xc2, 0xd4, 0x2c, 0xc7, 0x27, 0x37, 0x5a, 0x57, 0xda, 0x40, 0x73,
+	0x2d, 0xf9, 0x83, 0x4e, 0x2a, 0xbd, 0x07, 0xb3, 0xc1, 0xf0, 0x45, 0xaa, 0x6c, 0x62, 0x0a, 0x8b,
+	0xf3, 0x27, 0x4a, 0xad, 0x4c, 0xf3, 0x2d, 0xd5, 0x5c, 0x51, 0x2a, 0x7e, 0x0c, 0xc5, 0xa3, 0x31,
+	0x8b, 0xcc, 0xb1, 0xe4, 0xc8, 0xd5, 0xac, 0xb3, 0x44, 0x43, 0xa4, 0x8b, 0x0a, 0xa5, 0x4e, 0x4b,
+	0xd2, 0x78, 0x2b, 0x18, 0x1e, 0x24, 0xd2, 0x36, 0x14, 0xc2, 0xfe, 0x46, 0xea, 0x2c, 0x31, 0xf7,
+	0x34, 0xe7, 0x58, 0xb2, 0xf9, 0xd1, 0x05, 0x05, 0x54, 0xa5, 0x45, 0x05, 0xd4, 0xe7, 0xbe, 0x08,
+	0x08, 0x95, 0xda, 0xe8, 0x77, 0x3d, 0xbe, 0xab, 0x28, 0x55, 0x58, 0x7c, 0x58, 0x4c, 0xa1, 0xd3,
+	0x50, 0x28, 0x84, 0x56, 0x14, 0x8a, 0xad, 0x55, 0x25, 0xd2, 0x87, 0x00, 0x6d, 0xee, 0x5b, 0xbb,
+	0xfd, 0xb3, 0x01, 0x2d, 0x29, 0xa0, 0x39, 0x5a, 0x0e, 0x80, 0x02, 0x4d, 0x89, 0xd3, 0x86, 0xe2,
+	0xb6, 0x73, 0x66, 0x98, 0xc9, 0xf0, 0xa0, 0x13, 0xa2, 0xf4, 0x60, 0x3e, 0x65, 0xce, 0x24, 0xcb,
+	0xec, 0xe4, 0xe9, 0x33, 0x05, 0xfd, 0xb2, 0x42, 0x5f, 0xa6, 0x8b, 0x0a, 0xdd, 0xd7, 0xaa, 0x9d,
+	0x70, 0x0a, 0x92, 0x86, 0xbe, 0x84, 0x5a, 0xa2, 0x43, 0x93, 0xa5, 0x13, 0x7a, 0x76, 0xb3, 0xce,
+	0x12, 0xcd, 0x89, 0x5e, 0x52, 0x06, 0x96, 0x28, 0x69, 0xc9, 0x5f, 0xce, 0x1d, 0x69, 0xa5, 0xa7,
+	0x75, 0x25, 0xf8, 0x43, 0xa8, 0x4c, 0x34, 0x4e, 0x72, 0x3e, 0xb5, 0x91, 0xa6, 0x00, 0x2f, 0x2b,
+	0xe0, 0xf3, 0xb4, 0x1e, 0x01, 0x8f, 0x94, 0xa6, 0x84, 0xfd, 0x14, 0x20, 0x2a, 0xa6, 0x24, 0xa5,
+	0x69, 0x34, 0xd3, 0xaa, 0x6d, 0x2c, 0x65, 0xbb, 0x72, 0xb3, 0xa3, 0x8a, 0xbd, 0xc4, 0xeb, 0x42,
+	0x2d, 0x51, 0xed, 0xc9, 0x12, 0x4b, 0xef, 0x58, 0xcd, 0x06, 0x3b, 0xa1, 0x31, 0xc4, 0x62, 0x11,
+	0x83, 0x8f, 0x1e, 0xea, 0x36, 0x14, 0xc2, 0x12, 0x4d, 0xea, 0x2c, 0x51, 0xed, 0x9b, 0x73, 0x2c,
+	0x59, 0xbf, 0x63, 0x17, 0x3e, 0x2c, 0xb6, 0x12, 0x66, 0x0b, 0x20, 0xaa, 0xbe, 0x84, 0xb0, 0x63,
+	0xa5, 0x38, 0xfe, 0x84, 0xa3, 0xdb, 0x65, 0x63, 0x1c, 0x64, 0x1d, 0xb2, 0xf7, 0xb8, 0xd3, 0x23,
+	0xb3, 0x4c, 0x55, 0xdb, 0xb8, 0x4a, 0x45, 0xa9, 0xe4, 0x49, 0xae, 0x35, 0xe4, 0x4e, 0x6f, 0xb3,
+	0xf1, 0xf3, 0xcb, 0x15, 0xe3, 0xc5, 0xcb, 0x15, 0xe3, 0xf7, 0x97, 0x2b, 0xc6, 0x77, 0xaf, 0x56,
+	0xce, 0xbd, 0x78, 0xb5, 0x72, 0xee, 0xd7, 0x57, 0x2b, 0xe7, 0x76, 0x67, 0xd5, 0xdf, 0x35, 0x37,
+	0xfe, 0x1e, 0x00, 0xbd, 0xf5, 0x39, 0x9f, 0x26, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// KeyServiceClient is the client API for KeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KeyServiceClient interface {
+	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*Response, error)
+	EncryptBatch(ctx context.Context, in *EncryptBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	Decrypt(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*Response, error)
+	DecryptBatch(ctx context.Context, in *DecryptBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	Keys(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*KeyResponse, error)
+	// 轮换密钥，result为新的密钥版本
+	Rotate(ctx context.Context, in *RotateRequest, opts ...grpc.CallOption) (*Response, error)
+	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error)
+	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
+	DescribeKey(ctx context.Context, in *KeyIdRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error)
+	DisableKey(ctx context.Context, in *KeyIdRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error)
+	// 启用密钥，或取消计划删除
+	EnableKey(ctx context.Context, in *KeyIdRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error)
+	ScheduleKeyDeletion(ctx context.Context, in *ScheduleKeyDeletionRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error)
+	GenerateDataKey(ctx context.Context, in *GenerateDataKeyRequest, opts ...grpc.CallOption) (*DataKeyResponse, error)
+	UnwrapDataKey(ctx context.Context, in *UnwrapDataKeyRequest, opts ...grpc.CallOption) (*DataKeyResponse, error)
+	BlindIndex(ctx context.Context, in *BlindIndexRequest, opts ...grpc.CallOption) (*BlindIndexResponse, error)
+	BlindIndexBatch(ctx context.Context, in *BlindIndexBatchRequest, opts ...grpc.CallOption) (*BlindIndexBatchResponse, error)
+	Tokenize(ctx context.Context, in *TokenizeRequest, opts ...grpc.CallOption) (*TokenizeResponse, error)
+	Detokenize(ctx context.Context, in *DetokenizeRequest, opts ...grpc.CallOption) (*Response, error)
+	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error)
+}
+
+type keyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewKeyServiceClient(cc *grpc.ClientConn) KeyServiceClient {
+	return &keyServiceClient{cc}
+}
+
+func (c *keyServiceClient) Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/KeyService/Encrypt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) EncryptBatch(ctx context.Context, in *EncryptBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/EncryptBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Decrypt(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/KeyService/Decrypt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) DecryptBatch(ctx context.Context, in *DecryptBatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/DecryptBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Keys(ctx context.Context, in *KeyRequest, opts ...grpc.CallOption) (*KeyResponse, error) {
+	out := new(KeyResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/Keys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Rotate(ctx context.Context, in *RotateRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/KeyService/Rotate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error) {
+	out := new(KeyMetaResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/CreateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/ListKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) DescribeKey(ctx context.Context, in *KeyIdRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error) {
+	out := new(KeyMetaResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/DescribeKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) DisableKey(ctx context.Context, in *KeyIdRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error) {
+	out := new(KeyMetaResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/DisableKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) EnableKey(ctx context.Context, in *KeyIdRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error) {
+	out := new(KeyMetaResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/EnableKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) ScheduleKeyDeletion(ctx context.Context, in *ScheduleKeyDeletionRequest, opts ...grpc.CallOption) (*KeyMetaResponse, error) {
+	out := new(KeyMetaResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/ScheduleKeyDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) GenerateDataKey(ctx context.Context, in *GenerateDataKeyRequest, opts ...grpc.CallOption) (*DataKeyResponse, error) {
+	out := new(DataKeyResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/GenerateDataKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) UnwrapDataKey(ctx context.Context, in *UnwrapDataKeyRequest, opts ...grpc.CallOption) (*DataKeyResponse, error) {
+	out := new(DataKeyResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/UnwrapDataKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) BlindIndex(ctx context.Context, in *BlindIndexRequest, opts ...grpc.CallOption) (*BlindIndexResponse, error) {
+	out := new(BlindIndexResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/BlindIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) BlindIndexBatch(ctx context.Context, in *BlindIndexBatchRequest, opts ...grpc.CallOption) (*BlindIndexBatchResponse, error) {
+	out := new(BlindIndexBatchResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/BlindIndexBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Tokenize(ctx context.Context, in *TokenizeRequest, opts ...grpc.CallOption) (*TokenizeResponse, error) {
+	out := new(TokenizeResponse)
+	err := c.cc.Invoke(ctx, "/KeyService/Tokenize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Detokenize(ctx context.Context, in *DetokenizeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/KeyService/Detokenize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/KeyService/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyServiceServer is the server API for KeyService service.
+type KeyServiceServer interface {
+	Encrypt(context.Context, *EncryptRequest) (*Response, error)
+	EncryptBatch(context.Context, *EncryptBatchRequest) (*BatchResponse, error)
+	Decrypt(context.Context, *DecryptRequest) (*Response, error)
+	DecryptBatch(context.Context, *DecryptBatchRequest) (*BatchResponse, error)
+	Keys(context.Context, *KeyRequest) (*KeyResponse, error)
+	// 轮换密钥，result为新的密钥版本
+	Rotate(context.Context, *RotateRequest) (*Response, error)
+	CreateKey(context.Context, *CreateKeyRequest) (*KeyMetaResponse, error)
+	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
+	DescribeKey(context.Context, *KeyIdRequest) (*KeyMetaResponse, error)
+	DisableKey(context.Context, *KeyIdRequest) (*KeyMetaResponse, error)
+	// 启用密钥，或取消计划删除
+	EnableKey(context.Context, *KeyIdRequest) (*KeyMetaResponse, error)
+	ScheduleKeyDeletion(context.Context, *ScheduleKeyDeletionRequest) (*KeyMetaResponse, error)
+	GenerateDataKey(context.Context, *GenerateDataKeyRequest) (*DataKeyResponse, error)
+	UnwrapDataKey(context.Context, *UnwrapDataKeyRequest) (*DataKeyResponse, error)
+	BlindIndex(context.Context, *BlindIndexRequest) (*BlindIndexResponse, error)
+	BlindIndexBatch(context.Context, *BlindIndexBatchRequest) (*BlindIndexBatchResponse, error)
+	Tokenize(context.Context, *TokenizeRequest) (*TokenizeResponse, error)
+	Detokenize(context.Context, *DetokenizeRequest) (*Response, error)
+	Ping(context.Context, *Empty) (*Response, error)
+}
+
+// UnimplementedKeyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedKeyServiceServer struct {
+}
+
+func (*UnimplementedKeyServiceServer) Encrypt(ctx context.Context, req *EncryptRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Encrypt not implemented")
+}
+func (*UnimplementedKeyServiceServer) EncryptBatch(ctx context.Context, req *EncryptBatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptBatch not implemented")
+}
+func (*UnimplementedKeyServiceServer) Decrypt(ctx context.Context, req *DecryptRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decrypt not implemented")
+}
+func (*UnimplementedKeyServiceServer) DecryptBatch(ctx context.Context, req *DecryptBatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecryptBatch not implemented")
+}
+func (*UnimplementedKeyServiceServer) Keys(ctx context.Context, req *KeyRequest) (*KeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keys not implemented")
+}
+func (*UnimplementedKeyServiceServer) Rotate(ctx context.Context, req *RotateRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rotate not implemented")
+}
+func (*UnimplementedKeyServiceServer) CreateKey(ctx context.Context, req *CreateKeyRequest) (*KeyMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKey not implemented")
+}
+func (*UnimplementedKeyServiceServer) ListKeys(ctx context.Context, req *ListKeysRequest) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (*UnimplementedKeyServiceServer) DescribeKey(ctx context.Context, req *KeyIdRequest) (*KeyMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeKey not implemented")
+}
+func (*UnimplementedKeyServiceServer) DisableKey(ctx context.Context, req *KeyIdRequest) (*KeyMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableKey not implemented")
+}
+func (*UnimplementedKeyServiceServer) EnableKey(ctx context.Context, req *KeyIdRequest) (*KeyMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableKey not implemented")
+}
+func (*UnimplementedKeyServiceServer) ScheduleKeyDeletion(ctx context.Context, req *ScheduleKeyDeletionRequest) (*KeyMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleKeyDeletion not implemented")
+}
+func (*UnimplementedKeyServiceServer) GenerateDataKey(ctx context.Context, req *GenerateDataKeyRequest) (*DataKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDataKey not implemented")
+}
+func (*UnimplementedKeyServiceServer) UnwrapDataKey(ctx context.Context, req *UnwrapDataKeyRequest) (*DataKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwrapDataKey not implemented")
+}
+func (*UnimplementedKeyServiceServer) BlindIndex(ctx context.Context, req *BlindIndexRequest) (*BlindIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlindIndex not implemented")
+}
+func (*UnimplementedKeyServiceServer) BlindIndexBatch(ctx context.Context, req *BlindIndexBatchRequest) (*BlindIndexBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlindIndexBatch not implemented")
+}
+func (*UnimplementedKeyServiceServer) Tokenize(ctx context.Context, req *TokenizeRequest) (*TokenizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tokenize not implemented")
+}
+func (*UnimplementedKeyServiceServer) Detokenize(ctx context.Context, req *DetokenizeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Detokenize not implemented")
+}
+func (*UnimplementedKeyServiceServer) Ping(ctx context.Context, req *Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+
+func RegisterKeyServiceServer(s *grpc.Server, srv KeyServiceServer) {
+	s.RegisterService(&_KeyService_serviceDesc, srv)
+}
+
+func _KeyService_Encrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Encrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/Encrypt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Encrypt(ctx, req.(*EncryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_EncryptBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).EncryptBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/EncryptBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).EncryptBatch(ctx, req.(*EncryptBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Decrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Decrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/Decrypt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Decrypt(ctx, req.(*DecryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_DecryptBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecryptBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).DecryptBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/DecryptBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).DecryptBatch(ctx, req.(*DecryptBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Keys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Keys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/Keys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Keys(ctx, req.(*KeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Rotate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Rotate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/Rotate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Rotate(ctx, req.(*RotateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_CreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).CreateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/CreateKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).CreateKey(ctx, req.(*CreateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).ListKeys(ctx, req.(*ListKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_DescribeKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).DescribeKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/DescribeKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).DescribeKey(ctx, req.(*KeyIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_DisableKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).DisableKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/DisableKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).DisableKey(ctx, req.(*KeyIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_EnableKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).EnableKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/EnableKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).EnableKey(ctx, req.(*KeyIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_ScheduleKeyDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleKeyDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).ScheduleKeyDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/ScheduleKeyDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).ScheduleKeyDeletion(ctx, req.(*ScheduleKeyDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_GenerateDataKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateDataKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).GenerateDataKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/GenerateDataKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).GenerateDataKey(ctx, req.(*GenerateDataKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_UnwrapDataKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnwrapDataKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).UnwrapDataKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/UnwrapDataKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).UnwrapDataKey(ctx, req.(*UnwrapDataKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_BlindIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlindIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).BlindIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/BlindIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).BlindIndex(ctx, req.(*BlindIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_BlindIndexBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlindIndexBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).BlindIndexBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/BlindIndexBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).BlindIndexBatch(ctx, req.(*BlindIndexBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Tokenize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Tokenize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/Tokenize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Tokenize(ctx, req.(*TokenizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Detokenize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetokenizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Detokenize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/Detokenize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Detokenize(ctx, req.(*DetokenizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/KeyService/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).Ping(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _KeyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "KeyService",
+	HandlerType: (*KeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Encrypt",
+			Handler:    _KeyService_Encrypt_Handler,
+		},
+		{
+			MethodName: "EncryptBatch",
+			Handler:    _KeyService_EncryptBatch_Handler,
+		},
+		{
+			MethodName: "Decrypt",
+			Handler:    _KeyService_Decrypt_Handler,
+		},
+		{
+			MethodName: "DecryptBatch",
+			Handler:    _KeyService_DecryptBatch_Handler,
+		},
+		{
+			MethodName: "Keys",
+			Handler:    _KeyService_Keys_Handler,
+		},
+		{
+			MethodName: "Rotate",
+			Handler:    _KeyService_Rotate_Handler,
+		},
+		{
+			MethodName: "CreateKey",
+			Handler:    _KeyService_CreateKey_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _KeyService_ListKeys_Handler,
+		},
+		{
+			MethodName: "DescribeKey",
+			Handler:    _KeyService_DescribeKey_Handler,
+		},
+		{
+			MethodName: "DisableKey",
+			Handler:    _KeyService_DisableKey_Handler,
+		},
+		{
+			MethodName: "EnableKey",
+			Handler:    _KeyService_EnableKey_Handler,
+		},
+		{
+			MethodName: "ScheduleKeyDeletion",
+			Handler:    _KeyService_ScheduleKeyDeletion_Handler,
+		},
+		{
+			MethodName: "GenerateDataKey",
+			Handler:    _KeyService_GenerateDataKey_Handler,
+		},
+		{
+			MethodName: "UnwrapDataKey",
+			Handler:    _KeyService_UnwrapDataKey_Handler,
+		},
+		{
+			MethodName: "BlindIndex",
+			Handler:    _KeyService_BlindIndex_Handler,
+		},
+		{
+			MethodName: "BlindIndexBatch",
+			Handler:    _KeyService_BlindIndexBatch_Handler,
+		},
+		{
+			MethodName: "Tokenize",
+			Handler:    _KeyService_Tokenize_Handler,
+		},
+		{
+			MethodName: "Detokenize",
+			Handler:    _KeyService_Detokenize_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _KeyService_Ping_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "keyservice.proto",
+}
+
+func (m *EncryptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if len(m.Context) > 0 {
+		for k, _ := range m.Context {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Context[k]
+			mapSize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			i = encodeVarintKeyservice(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.RawData) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.RawData)))
+		i += copy(dAtA[i:], m.RawData)
+	}
+	if m.Deterministic {
+		dAtA[i] = 0x28
+		i++
+		if m.Deterministic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *EncryptBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *DecryptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecryptRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if len(m.Cipher) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Cipher)))
+		i += copy(dAtA[i:], m.Cipher)
+	}
+	if len(m.Context) > 0 {
+		for k, _ := range m.Context {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Context[k]
+			mapSize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			i = encodeVarintKeyservice(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.RawCipher) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.RawCipher)))
+		i += copy(dAtA[i:], m.RawCipher)
+	}
+	if len(m.Mask) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Mask)))
+		i += copy(dAtA[i:], m.Mask)
+	}
+	return i, nil
+}
+
+func (m *DecryptBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecryptBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Response) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if len(m.Result) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
+	}
+	if len(m.RawResult) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.RawResult)))
+		i += copy(dAtA[i:], m.RawResult)
+	}
+	return i, nil
+}
+
+func (m *BatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *KeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyIds) > 0 {
+		for _, s := range m.KeyIds {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *KeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if len(m.Result) > 0 {
+		for k, _ := range m.Result {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Result[k]
+			mapSize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			i = encodeVarintKeyservice(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *RotateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	return i, nil
+}
+
+func (m *KeyIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	return i, nil
+}
+
+func (m *CreateKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if m.RotationPeriod != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.RotationPeriod))
+	}
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
+	}
+	return i, nil
+}
+
+func (m *ScheduleKeyDeletionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleKeyDeletionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if m.PendingWindow != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.PendingWindow))
+	}
+	return i, nil
+}
+
+func (m *ListKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	if m.PageSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.PageSize))
+	}
+	return i, nil
+}
+
+func (m *KeyVersionMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyVersionMeta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Version))
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.UpdatedAt))
+	}
+	return i, nil
+}
+
+func (m *KeyMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyMeta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Version))
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if m.RotationPeriod != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.RotationPeriod))
+	}
+	if m.DeletionTime != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.DeletionTime))
+	}
+	if len(m.Versions) > 0 {
+		for _, msg := range m.Versions {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
+	}
+	return i, nil
+}
+
+func (m *KeyMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if m.Result != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Result.Size()))
+		n1, err1 := m.Result.MarshalTo(dAtA[i:])
+		if err1 != nil {
+			return 0, err1
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *ListKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if len(m.Result) > 0 {
+		for _, msg := range m.Result {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	return i, nil
+}
+
+func (m *GenerateDataKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateDataKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if len(m.Context) > 0 {
+		for k, _ := range m.Context {
+			dAtA[i] = 0x12
+			i++
+			v := m.Context[k]
+			mapSize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			i = encodeVarintKeyservice(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *UnwrapDataKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnwrapDataKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if len(m.Wrapped) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Wrapped)))
+		i += copy(dAtA[i:], m.Wrapped)
+	}
+	if len(m.Context) > 0 {
+		for k, _ := range m.Context {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Context[k]
+			mapSize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			i = encodeVarintKeyservice(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *DataKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if len(m.Plaintext) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Plaintext)))
+		i += copy(dAtA[i:], m.Plaintext)
+	}
+	if len(m.Wrapped) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Wrapped)))
+		i += copy(dAtA[i:], m.Wrapped)
+	}
+	return i, nil
+}
+
+func (m *BlindIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlindIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.AllVersions {
+		dAtA[i] = 0x18
+		i++
+		if m.AllVersions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *BlindIndexBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlindIndexBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, msg := range m.Items {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BlindIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlindIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if len(m.Result) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
+	}
+	if len(m.Indexes) > 0 {
+		for _, s := range m.Indexes {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *BlindIndexBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlindIndexBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if len(m.Results) > 0 {
+		for _, msg := range m.Results {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintKeyservice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *TokenizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *TokenizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
+	}
+	if len(m.Result) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DetokenizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DetokenizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.KeyId)))
+		i += copy(dAtA[i:], m.KeyId)
+	}
+	if len(m.Token) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(len(m.Token)))
+		i += copy(dAtA[i:], m.Token)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintKeyservice(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeVarintKeyservice(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *EncryptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Context) > 0 {
+		for k, v := range m.Context {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovKeyservice(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.RawData)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.Deterministic {
+		n += 2
+	}
+	return n
+}
+
+func (m *EncryptBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DecryptRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Cipher)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Context) > 0 {
+		for k, v := range m.Context {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovKeyservice(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.RawCipher)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Mask)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *DecryptBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.RawResult)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.KeyIds) > 0 {
+		for _, s := range m.KeyIds {
+			l = len(s)
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Result) > 0 {
+		for k, v := range m.Result {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovKeyservice(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *RotateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.RotationPeriod != 0 {
+		n += 1 + sovKeyservice(uint64(m.RotationPeriod))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduleKeyDeletionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.PendingWindow != 0 {
+		n += 1 + sovKeyservice(uint64(m.PendingWindow))
+	}
+	return n
+}
+
+func (m *ListKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovKeyservice(uint64(m.PageSize))
+	}
+	return n
+}
+
+func (m *KeyVersionMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovKeyservice(uint64(m.Version))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovKeyservice(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovKeyservice(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *KeyMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovKeyservice(uint64(m.Version))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.RotationPeriod != 0 {
+		n += 1 + sovKeyservice(uint64(m.RotationPeriod))
+	}
+	if m.DeletionTime != 0 {
+		n += 1 + sovKeyservice(uint64(m.DeletionTime))
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *ListKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Result) > 0 {
+		for _, e := range m.Result {
+			l = e.Size()
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *GenerateDataKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Context) > 0 {
+		for k, v := range m.Context {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovKeyservice(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *UnwrapDataKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Wrapped)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Context) > 0 {
+		for k, v := range m.Context {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovKeyservice(uint64(len(k))) + 1 + len(v) + sovKeyservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovKeyservice(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *DataKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Plaintext)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Wrapped)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *BlindIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.AllVersions {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlindIndexBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlindIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Indexes) > 0 {
+		for _, s := range m.Indexes {
+			l = len(s)
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlindIndexBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovKeyservice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovKeyservice(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovKeyservice(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DetokenizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovKeyservice(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovKeyservice(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *Empty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovKeyservice(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozKeyservice(x uint64) (n int) {
+	return sovKeyservice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeyservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipKeyservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Context[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawData = append(m.RawData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawData == nil {
+				m.RawData = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deterministic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deterministic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &EncryptRequest{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecryptRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecryptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cipher", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cipher = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeyservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipKeyservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Context[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawCipher", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawCipher = append(m.RawCipher[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawCipher == nil {
+				m.RawCipher = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mask", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mask = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecryptBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecryptBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecryptBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &DecryptRequest{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawResult", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawResult = append(m.RawResult[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawResult == nil {
+				m.RawResult = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &Response{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyIds = append(m.KeyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeyservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipKeyservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Result[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RotateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationPeriod", wireType)
+			}
+			m.RotationPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleKeyDeletionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleKeyDeletionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleKeyDeletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWindow", wireType)
+			}
+			m.PendingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyVersionMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyVersionMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyVersionMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationPeriod", wireType)
+			}
+			m.RotationPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotationPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletionTime", wireType)
+			}
+			m.DeletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletionTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, &KeyVersionMeta{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &KeyMeta{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result, &KeyMeta{})
+			if err := m.Result[len(m.Result)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenerateDataKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateDataKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateDataKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeyservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipKeyservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Context[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnwrapDataKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnwrapDataKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnwrapDataKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrapped", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wrapped = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Context == nil {
+				m.Context = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowKeyservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowKeyservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipKeyservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthKeyservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Context[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeyservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plaintext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plaintext = append(m.Plaintext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Plaintext == nil {
+				m.Plaintext = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrapped", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wrapped = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeyservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Empty) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovKeyservice(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozKeyservice(x uint64) (n int) {
-	return sovKeyservice(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
+func (m *BlindIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1280,10 +7485,10 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlindIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlindIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1320,7 +7525,7 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1348,8 +7553,28 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllVersions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllVersions = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeyservice(dAtA[iNdEx:])
@@ -1374,7 +7599,7 @@ func (m *EncryptRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EncryptBatchRequest) Unmarshal(dAtA []byte) error {
+func (m *BlindIndexBatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1397,10 +7622,10 @@ func (m *EncryptBatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EncryptBatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlindIndexBatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EncryptBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlindIndexBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1432,7 +7657,7 @@ func (m *EncryptBatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &EncryptRequest{})
+			m.Items = append(m.Items, &BlindIndexRequest{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1461,7 +7686,7 @@ func (m *EncryptBatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
+func (m *BlindIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1484,15 +7709,34 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlindIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlindIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1520,11 +7764,11 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyId = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cipher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1552,7 +7796,39 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cipher = string(dAtA[iNdEx:postIndex])
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1578,7 +7854,7 @@ func (m *DecryptRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptBatchRequest) Unmarshal(dAtA []byte) error {
+func (m *BlindIndexBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1601,15 +7877,66 @@ func (m *DecryptBatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptBatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlindIndexBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlindIndexBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeyservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1636,8 +7963,8 @@ func (m *DecryptBatchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &DecryptRequest{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &BlindIndexResponse{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1665,7 +7992,7 @@ func (m *DecryptBatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Response) Unmarshal(dAtA []byte) error {
+func (m *TokenizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1688,34 +8015,15 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeyservice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1743,11 +8051,11 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.KeyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1775,7 +8083,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1801,7 +8109,7 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchResponse) Unmarshal(dAtA []byte) error {
+func (m *TokenizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1824,10 +8132,10 @@ func (m *BatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1883,9 +8191,9 @@ func (m *BatchResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyservice
@@ -1895,26 +8203,43 @@ func (m *BatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthKeyservice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthKeyservice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Results = append(m.Results, &Response{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeyservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeyservice(dAtA[iNdEx:])
@@ -1939,7 +8264,7 @@ func (m *BatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyRequest) Unmarshal(dAtA []byte) error {
+func (m *DetokenizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1962,15 +8287,15 @@ func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DetokenizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DetokenizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1998,83 +8323,11 @@ func (m *KeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyIds = append(m.KeyIds, string(dAtA[iNdEx:postIndex]))
+			m.KeyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipKeyservice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthKeyservice
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthKeyservice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KeyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowKeyservice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KeyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowKeyservice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2102,13 +8355,13 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowKeyservice
@@ -2118,119 +8371,11 @@ func (m *KeyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthKeyservice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthKeyservice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Result == nil {
-				m.Result = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowKeyservice
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowKeyservice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthKeyservice
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthKeyservice
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowKeyservice
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthKeyservice
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthKeyservice
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipKeyservice(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthKeyservice
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Result[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipKeyservice(dAtA[iNdEx:])
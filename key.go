@@ -0,0 +1,243 @@
+package keyservice
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// KeyState is the state of a key version
+type KeyState string
+
+const (
+	// KeyStateActive can be used to encrypt and decrypt, only the current version is active
+	KeyStateActive KeyState = "active"
+	// KeyStateDecryptOnly can only be used to decrypt data encrypted by this version
+	KeyStateDecryptOnly KeyState = "decrypt-only"
+	// KeyStateDisabled can't be used, but could be enabled again
+	KeyStateDisabled KeyState = "disabled"
+	// KeyStateDestroyed key value has been removed, can't be used any more
+	KeyStateDestroyed KeyState = "destroyed"
+	// KeyStatePendingDeletion key will be deleted at DeletionTime, can't be used
+	KeyStatePendingDeletion KeyState = "pending-deletion"
+)
+
+// EncryptionMode is the encryption mode of a key
+type EncryptionMode string
+
+const (
+	// EncryptionModeRandomized encrypts with random nonce, the same plaintext yields different ciphertext
+	EncryptionModeRandomized EncryptionMode = "randomized"
+	// EncryptionModeDeterministic the same plaintext yields the same ciphertext under the same key version and context,
+	// so that equality lookups can be done on encrypted data
+	EncryptionModeDeterministic EncryptionMode = "deterministic"
+)
+
+// KeyVersion contains key value of a version
+type KeyVersion struct {
+	Version   uint16   `json:"n"`           // 密钥版本
+	Value     string   `json:"v,omitempty"` // 密钥
+	State     KeyState `json:"s,omitempty"` // 状态
+	CreatedAt int64    `json:"c,omitempty"` // 创建时间(unix秒)
+	UpdatedAt int64    `json:"u,omitempty"` // 状态更新时间(unix秒)
+}
+
+// Key contains data of key
+type Key struct {
+	Version        uint16        `json:"n,omitempty"` // 当前生效的密钥版本
+	Versions       []*KeyVersion `json:"h,omitempty"` // 所有版本的密钥，按版本升序
+	RotationPeriod int64         `json:"r,omitempty"` // 自动轮换周期(秒)，0表示不自动轮换
+	// 密钥状态，空(active)、disabled或pending-deletion，非active状态时所有版本均不可用
+	State        KeyState `json:"st,omitempty"`
+	DeletionTime int64    `json:"dt,omitempty"` // 计划删除时间(unix秒)
+	// 计划删除前的密钥状态，取消删除时恢复
+	PreviousState KeyState `json:"ps,omitempty"`
+	// 加密模式，空(randomized)或deterministic
+	Mode EncryptionMode `json:"m,omitempty"`
+}
+
+// NewKey returns key with value as the first active version
+func NewKey(value string) *Key {
+	now := time.Now().Unix()
+	return &Key{
+		Version: 1,
+		Versions: []*KeyVersion{
+			{
+				Version:   1,
+				Value:     value,
+				State:     KeyStateActive,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+	}
+}
+
+// keyJSON is json format of key, compatible with legacy format(n/v/o)
+type keyJSON struct {
+	Version          uint16         `json:"n,omitempty"` // 密钥版本
+	Value            string         `json:"v,omitempty"` // 当前生效的密钥(旧格式)
+	ValueWillExpired string         `json:"o,omitempty"` // 即将过期的密钥(旧格式)
+	Versions         []*KeyVersion  `json:"h,omitempty"`
+	RotationPeriod   int64          `json:"r,omitempty"`
+	State            KeyState       `json:"st,omitempty"`
+	DeletionTime     int64          `json:"dt,omitempty"`
+	PreviousState    KeyState       `json:"ps,omitempty"`
+	Mode             EncryptionMode `json:"m,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, key in legacy format(n/v/o) is migrated
+// to version history.
+func (k *Key) UnmarshalJSON(data []byte) error {
+	var lk keyJSON
+	if err := json.Unmarshal(data, &lk); err != nil {
+		return err
+	}
+
+	k.Version = lk.Version
+	k.Versions = lk.Versions
+	k.RotationPeriod = lk.RotationPeriod
+	k.State = lk.State
+	k.DeletionTime = lk.DeletionTime
+	k.PreviousState = lk.PreviousState
+	k.Mode = lk.Mode
+
+	if len(k.Versions) == 0 && lk.Value != "" {
+		if lk.ValueWillExpired != "" {
+			k.Versions = append(k.Versions, &KeyVersion{
+				Version: lk.Version - 1,
+				Value:   lk.ValueWillExpired,
+				State:   KeyStateDecryptOnly,
+			})
+		}
+		k.Versions = append(k.Versions, &KeyVersion{
+			Version: lk.Version,
+			Value:   lk.Value,
+			State:   KeyStateActive,
+		})
+	}
+
+	return nil
+}
+
+// Get returns the specified version, or nil if not exists
+func (k *Key) Get(version uint16) *KeyVersion {
+	for _, v := range k.Versions {
+		if v.Version == version {
+			return v
+		}
+	}
+	return nil
+}
+
+// Current returns the current version, or nil if not exists
+func (k *Key) Current() *KeyVersion {
+	return k.Get(k.Version)
+}
+
+// Enabled reports whether the key is not disabled or pending deletion
+func (k *Key) Enabled() bool {
+	return k.State == "" || k.State == KeyStateActive
+}
+
+// IsActive reports whether the key can be used to encrypt
+func (k *Key) IsActive() bool {
+	if !k.Enabled() {
+		return false
+	}
+	v := k.Current()
+	return v != nil && v.State == KeyStateActive && v.Value != ""
+}
+
+// valueOf returns key value that can decrypt data of the specified version,
+// or empty string if not exists or not usable
+func (k *Key) valueOf(version uint16) string {
+	if !k.Enabled() {
+		return ""
+	}
+	v := k.Get(version)
+	if v == nil {
+		return ""
+	}
+	if v.State != KeyStateActive && v.State != KeyStateDecryptOnly {
+		return ""
+	}
+	return v.Value
+}
+
+// NeedRotation reports whether the current version is older than RotationPeriod
+func (k *Key) NeedRotation(now time.Time) bool {
+	if k.RotationPeriod <= 0 || !k.Enabled() {
+		return false
+	}
+	v := k.Current()
+	if v == nil {
+		return false
+	}
+	return v.CreatedAt+k.RotationPeriod <= now.Unix()
+}
+
+func (k *Key) Copy() *Key {
+	c := &Key{
+		Version:        k.Version,
+		RotationPeriod: k.RotationPeriod,
+		State:          k.State,
+		DeletionTime:   k.DeletionTime,
+		PreviousState:  k.PreviousState,
+		Mode:           k.Mode,
+	}
+	if k.Versions != nil {
+		c.Versions = make([]*KeyVersion, len(k.Versions))
+		for i, v := range k.Versions {
+			cv := *v
+			c.Versions[i] = &cv
+		}
+	}
+	return c
+}
+
+// KeyVersionMetadata is KeyVersion without key value
+type KeyVersionMetadata struct {
+	Version   uint16
+	State     KeyState
+	CreatedAt int64
+	UpdatedAt int64
+}
+
+// KeyMetadata is Key without key values
+type KeyMetadata struct {
+	ID             string
+	Version        uint16
+	State          KeyState
+	RotationPeriod int64
+	DeletionTime   int64
+	Mode           EncryptionMode
+	Versions       []*KeyVersionMetadata
+}
+
+// Metadata returns metadata of key
+func (k *Key) Metadata(id string) *KeyMetadata {
+	m := &KeyMetadata{
+		ID:             id,
+		Version:        k.Version,
+		State:          k.State,
+		RotationPeriod: k.RotationPeriod,
+		DeletionTime:   k.DeletionTime,
+		Mode:           k.Mode,
+		Versions:       make([]*KeyVersionMetadata, len(k.Versions)),
+	}
+	if m.State == "" {
+		m.State = KeyStateActive
+	}
+	if m.Mode == "" {
+		m.Mode = EncryptionModeRandomized
+	}
+	for i, v := range k.Versions {
+		m.Versions[i] = &KeyVersionMetadata{
+			Version:   v.Version,
+			State:     v.State,
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
+		}
+	}
+	return m
+}
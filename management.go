@@ -0,0 +1,248 @@
+package keyservice
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	ErrKeyExists            = errors.New("Key exists")
+	ErrStorageNotDeletable  = errors.New("Storage can't delete keys")
+	ErrInvalidPendingWindow = errors.New("Invalid pending window")
+	ErrInvalidMode          = errors.New("Invalid encryption mode")
+)
+
+// KeyOptions are options of key created by CreateKey
+type KeyOptions struct {
+	// 自动轮换周期(秒)，0表示不自动轮换
+	RotationPeriod int64
+	// 加密模式，空表示randomized
+	Mode EncryptionMode
+}
+
+var (
+	// 默认分页大小和最大分页大小
+	defaultPageSize = 100
+	maxPageSize     = 1000
+
+	// 计划删除等待期的默认值和取值范围
+	defaultPendingWindow = 30 * 24 * time.Hour
+	minPendingWindow     = 24 * time.Hour
+	maxPendingWindow     = 365 * 24 * time.Hour
+)
+
+// CreateKey creates key with random value
+func (sv *KeyService) CreateKey(id string, opts KeyOptions) (*Key, error) {
+	switch opts.Mode {
+	case "", EncryptionModeRandomized:
+		opts.Mode = ""
+	case EncryptionModeDeterministic:
+	default:
+		return nil, ErrInvalidMode
+	}
+
+	defer sv.lockKey(id)()
+
+	keys, err := sv.storage.LoadMany([]string{id})
+	if err != nil {
+		return nil, err
+	}
+	if keys[id] != nil {
+		return nil, ErrKeyExists
+	}
+
+	value, err := generateKeyValue()
+	if err != nil {
+		return nil, err
+	}
+
+	key := NewKey(value)
+	if opts.RotationPeriod > 0 {
+		key.RotationPeriod = opts.RotationPeriod
+	}
+	key.Mode = opts.Mode
+
+	// 其他实例可能同时创建了该key
+	err = sv.compareAndStoreKey(id, nil, key)
+	if err == ErrConflict {
+		return nil, ErrKeyExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Infof("create key=%s", id)
+
+	return key, nil
+}
+
+// ListKeys returns metadata of keys after pageToken(exclusive) in id order,
+// nextPageToken is empty if there are no more keys.
+func (sv *KeyService) ListKeys(pageToken string, pageSize int) (keys []*KeyMetadata, nextPageToken string, err error) {
+	storage, ok := sv.storage.(IterableStorage)
+	if !ok {
+		return nil, "", ErrStorageNotIterable
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	ids, err := storage.IDs()
+	if err != nil {
+		return
+	}
+
+	start := 0
+	if pageToken != "" {
+		start = sort.SearchStrings(ids, pageToken)
+		if start < len(ids) && ids[start] == pageToken {
+			start++
+		}
+	}
+	end := start + pageSize
+	if end >= len(ids) {
+		end = len(ids)
+	} else {
+		nextPageToken = ids[end-1]
+	}
+	ids = ids[start:end]
+
+	rkeys, err := sv.storage.LoadMany(ids)
+	if err != nil {
+		return nil, "", err
+	}
+
+	keys = make([]*KeyMetadata, 0, len(ids))
+	for _, id := range ids {
+		if key := rkeys[id]; key != nil {
+			keys = append(keys, key.Metadata(id))
+		}
+	}
+
+	return
+}
+
+// DescribeKey returns metadata of key
+func (sv *KeyService) DescribeKey(id string) (*KeyMetadata, error) {
+	key, err := sv.loadKey(id)
+	if err != nil {
+		return nil, err
+	}
+	return key.Metadata(id), nil
+}
+
+// DisableKey disables key, all versions of the key can't be used until enabled again
+func (sv *KeyService) DisableKey(id string) (*Key, error) {
+	return sv.updateKey(id, func(key *Key) error {
+		if key.State == KeyStatePendingDeletion {
+			return ErrKeyDisabled
+		}
+		key.State = KeyStateDisabled
+		return nil
+	})
+}
+
+// EnableKey enables disabled key, or cancels deletion of key pending deletion.
+// Canceled key returns to the state before deletion was scheduled, e.g. disabled key stays disabled.
+func (sv *KeyService) EnableKey(id string) (*Key, error) {
+	return sv.updateKey(id, func(key *Key) error {
+		if key.State == KeyStatePendingDeletion {
+			key.State = key.PreviousState
+		} else {
+			key.State = ""
+		}
+		key.PreviousState = ""
+		key.DeletionTime = 0
+		return nil
+	})
+}
+
+// ScheduleKeyDeletion disables key and deletes it after pendingWindow, 0 for the default window.
+// The deletion can be canceled by EnableKey before it's deleted.
+func (sv *KeyService) ScheduleKeyDeletion(id string, pendingWindow time.Duration) (*Key, error) {
+	if _, ok := sv.storage.(DeletableStorage); !ok {
+		return nil, ErrStorageNotDeletable
+	}
+
+	if pendingWindow == 0 {
+		pendingWindow = defaultPendingWindow
+	}
+	if pendingWindow < minPendingWindow || pendingWindow > maxPendingWindow {
+		return nil, ErrInvalidPendingWindow
+	}
+
+	return sv.updateKey(id, func(key *Key) error {
+		if key.State != KeyStatePendingDeletion {
+			key.PreviousState = key.State
+		}
+		key.State = KeyStatePendingDeletion
+		key.DeletionTime = time.Now().Add(pendingWindow).Unix()
+		return nil
+	})
+}
+
+// DeleteDueKeys deletes keys pending deletion whose deletion time has come, returns ids of deleted keys
+func (sv *KeyService) DeleteDueKeys() (deleted []string, err error) {
+	storage, ok := sv.storage.(DeletableStorage)
+	if !ok {
+		return nil, ErrStorageNotDeletable
+	}
+	iterable, ok := sv.storage.(IterableStorage)
+	if !ok {
+		return nil, ErrStorageNotIterable
+	}
+
+	ids, err := iterable.IDs()
+	if err != nil {
+		return
+	}
+
+	now := time.Now().Unix()
+	for _, id := range ids {
+		key, e := sv.loadKey(id)
+		if e != nil {
+			err = e
+			logger.Errorf("delete key=%s load err=%v", id, e)
+			continue
+		}
+		if key.State != KeyStatePendingDeletion || key.DeletionTime > now {
+			continue
+		}
+		e = sv.deleteKey(storage, id, key)
+		if e == ErrConflict {
+			// 删除被取消或key被其他实例修改，下次检查时重新判断
+			logger.Infof("delete key=%s skipped, modified concurrently", id)
+			continue
+		}
+		if e != nil {
+			err = e
+			logger.Errorf("delete key=%s err=%v", id, e)
+			continue
+		}
+		logger.Infof("delete key=%s", id)
+		deleted = append(deleted, id)
+	}
+
+	return
+}
+
+// deleteKey deletes key only if the stored key is still old, and removes it from cache.
+// Storage not implementing ConditionalStorage deletes key unconditionally.
+func (sv *KeyService) deleteKey(storage DeletableStorage, id string, old *Key) error {
+	defer sv.lockKey(id)()
+
+	if cs, ok := storage.(ConditionalStorage); ok {
+		if err := cs.CompareAndDelete(id, old); err != nil {
+			return err
+		}
+	} else if err := storage.Delete(id); err != nil {
+		return err
+	}
+	sv.cache.Delete(id)
+	return nil
+}
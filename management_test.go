@@ -0,0 +1,273 @@
+package keyservice
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateKey(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	key, err := sv.CreateKey("key-new", KeyOptions{RotationPeriod: 86400})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(1), key.Version)
+	assert.Equal(t, int64(86400), key.RotationPeriod)
+	assert.True(t, key.IsActive())
+
+	keys, err := s.LoadMany([]string{"key-new"})
+	require.NoError(t, err)
+	assert.EqualValues(t, key, keys["key-new"])
+
+	str := "hello,world!"
+	encrypted, err := sv.Encrypt(str, "key-new")
+	require.NoError(t, err)
+	decrypted, err := sv.Decrypt(encrypted, "key-new")
+	require.NoError(t, err)
+	assert.Equal(t, str, decrypted)
+
+	_, err = sv.CreateKey("key-new", KeyOptions{})
+	assert.Equal(t, ErrKeyExists, err)
+	_, err = sv.CreateKey(_testKeyId1, KeyOptions{})
+	assert.Equal(t, ErrKeyExists, err)
+	_, err = sv.CreateKey("key-invalid-mode", KeyOptions{Mode: "invalid"})
+	assert.Equal(t, ErrInvalidMode, err)
+
+	key, err = sv.CreateKey("key-deterministic", KeyOptions{Mode: EncryptionModeDeterministic})
+	require.NoError(t, err)
+	assert.Equal(t, EncryptionModeDeterministic, key.Mode)
+	meta, err := sv.DescribeKey("key-deterministic")
+	require.NoError(t, err)
+	assert.Equal(t, EncryptionModeDeterministic, meta.Mode)
+}
+
+func TestListKeys(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	for i := 0; i < 5; i++ {
+		_, err := sv.CreateKey(fmt.Sprintf("key-%d", i), KeyOptions{})
+		require.NoError(t, err)
+	}
+
+	var ids []string
+	token := ""
+	for pages := 0; ; pages++ {
+		require.True(t, pages < 4)
+		keys, next, err := sv.ListKeys(token, 3)
+		require.NoError(t, err)
+		for _, key := range keys {
+			assert.NotZero(t, key.Version)
+			assert.NotEmpty(t, key.Versions)
+			ids = append(ids, key.ID)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	assert.Equal(t, []string{"key-0", "key-1", "key-2", "key-3", "key-4", _testKeyId1, _testKeyId2}, ids)
+
+	keys, next, err := sv.ListKeys("", 0)
+	require.NoError(t, err)
+	assert.Equal(t, 7, len(keys))
+	assert.Empty(t, next)
+
+	_, _, err = NewKeyService("seed-key", &storageWithoutIDs{s}, newTestCache()).ListKeys("", 0)
+	assert.Equal(t, ErrStorageNotIterable, err)
+}
+
+type storageWithoutIDs struct {
+	s Storage
+}
+
+func (s *storageWithoutIDs) Store(id string, key *Key) error {
+	return s.s.Store(id, key)
+}
+
+func (s *storageWithoutIDs) LoadMany(ids []string) (map[string]*Key, error) {
+	return s.s.LoadMany(ids)
+}
+
+func TestDescribeKey(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	meta, err := sv.DescribeKey(_testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, _testKeyId2, meta.ID)
+	assert.Equal(t, uint16(2), meta.Version)
+	assert.Equal(t, KeyStateActive, meta.State)
+	require.Equal(t, 2, len(meta.Versions))
+	assert.Equal(t, KeyStateDecryptOnly, meta.Versions[0].State)
+	assert.Equal(t, KeyStateActive, meta.Versions[1].State)
+
+	_, err = sv.DescribeKey("key-not-exists-id")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestDisableKey(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	str := "hello,world!"
+	encrypted, err := sv.Encrypt(str, _testKeyId1)
+	require.NoError(t, err)
+
+	key, err := sv.DisableKey(_testKeyId1)
+	require.NoError(t, err)
+	assert.Equal(t, KeyStateDisabled, key.State)
+
+	_, err = sv.Encrypt(str, _testKeyId1)
+	assert.Equal(t, ErrKeyDisabled, err)
+	_, err = sv.Decrypt(encrypted, _testKeyId1)
+	assert.Equal(t, ErrKeyDisabled, err)
+	_, err = sv.RotateKey(_testKeyId1)
+	assert.Equal(t, ErrKeyDisabled, err)
+
+	key, err = sv.EnableKey(_testKeyId1)
+	require.NoError(t, err)
+	assert.True(t, key.Enabled())
+
+	decrypted, err := sv.Decrypt(encrypted, _testKeyId1)
+	require.NoError(t, err)
+	assert.Equal(t, str, decrypted)
+
+	_, err = sv.DisableKey("key-not-exists-id")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestScheduleKeyDeletion(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	_, err := sv.ScheduleKeyDeletion(_testKeyId1, time.Hour)
+	assert.Equal(t, ErrInvalidPendingWindow, err)
+
+	key, err := sv.ScheduleKeyDeletion(_testKeyId1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, KeyStatePendingDeletion, key.State)
+	assert.InDelta(t, time.Now().Add(defaultPendingWindow).Unix(), key.DeletionTime, 5)
+
+	_, err = sv.Encrypt("hello", _testKeyId1)
+	assert.Equal(t, ErrKeyDisabled, err)
+
+	// not due yet
+	deleted, err := sv.DeleteDueKeys()
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+
+	// canceled by EnableKey
+	key, err = sv.EnableKey(_testKeyId1)
+	require.NoError(t, err)
+	assert.True(t, key.Enabled())
+	assert.Zero(t, key.DeletionTime)
+
+	key, err = sv.ScheduleKeyDeletion(_testKeyId1, 0)
+	require.NoError(t, err)
+	key.DeletionTime = time.Now().Add(-time.Second).Unix()
+	require.NoError(t, s.Store(_testKeyId1, key))
+
+	deleted, err = sv.DeleteDueKeys()
+	require.NoError(t, err)
+	assert.Equal(t, []string{_testKeyId1}, deleted)
+	assert.Nil(t, sv.GetKey(_testKeyId1))
+
+	keys, err := s.LoadMany([]string{_testKeyId1})
+	require.NoError(t, err)
+	assert.Nil(t, keys[_testKeyId1])
+
+	_, err = NewKeyService("seed-key", &storageWithoutIDs{s}, newTestCache()).ScheduleKeyDeletion(_testKeyId2, 0)
+	assert.Equal(t, ErrStorageNotDeletable, err)
+
+	// disabled key stays disabled when deletion is canceled
+	_, err = sv.DisableKey(_testKeyId2)
+	require.NoError(t, err)
+	_, err = sv.ScheduleKeyDeletion(_testKeyId2, 0)
+	require.NoError(t, err)
+	key, err = sv.ScheduleKeyDeletion(_testKeyId2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, KeyStateDisabled, key.PreviousState)
+	key, err = sv.EnableKey(_testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, KeyStateDisabled, key.State)
+	assert.Zero(t, key.DeletionTime)
+	key, err = sv.EnableKey(_testKeyId2)
+	require.NoError(t, err)
+	assert.True(t, key.Enabled())
+}
+
+// conflictStorage calls beforeDelete before deleting key
+type conflictStorage struct {
+	*FileStorage
+	beforeDelete func()
+}
+
+func (s *conflictStorage) CompareAndDelete(id string, old *Key) error {
+	s.beforeDelete()
+	return s.FileStorage.CompareAndDelete(id, old)
+}
+
+func TestKeyManagementConcurrently(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	secret := "secret-key"
+	require.NoError(t, writeFileKeyStoreContents(file, map[string]*Key{}, secret))
+	defer os.Remove(file)
+	defer os.Remove(file + ".lock")
+
+	newService := func() *KeyService {
+		s, err := NewFileStorage(file, secret)
+		require.NoError(t, err)
+		return NewKeyService("seed-key", s, NoCache)
+	}
+
+	// only one instance creates the key
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		created int
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(sv *KeyService) {
+			defer wg.Done()
+			_, err := sv.CreateKey("key1", KeyOptions{})
+			if err == ErrKeyExists {
+				return
+			}
+			if assert.NoError(t, err) {
+				mu.Lock()
+				created++
+				mu.Unlock()
+			}
+		}(newService())
+	}
+	wg.Wait()
+	assert.Equal(t, 1, created)
+
+	// deletion canceled by other instance after the key is loaded
+	sv1, sv2 := newService(), newService()
+	key, err := sv1.ScheduleKeyDeletion("key1", 0)
+	require.NoError(t, err)
+	key.DeletionTime = time.Now().Add(-time.Second).Unix()
+	require.NoError(t, sv1.storage.Store("key1", key))
+
+	s, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+	sv := NewKeyService("seed-key", &conflictStorage{s, func() {
+		_, err := sv2.EnableKey("key1")
+		require.NoError(t, err)
+	}}, NoCache)
+	deleted, err := sv.DeleteDueKeys()
+	require.NoError(t, err)
+	assert.Empty(t, deleted)
+	key, err = sv1.loadKey("key1")
+	require.NoError(t, err)
+	assert.True(t, key.Enabled())
+}
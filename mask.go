@@ -0,0 +1,125 @@
+package keyservice
+
+import (
+	"errors"
+	"strings"
+	"sync"
+)
+
+var ErrUnknownMaskPolicy = errors.New("Unknown mask policy")
+
+// 掩码字符
+const maskChar = '*'
+
+// MaskFunc masks value, it should never return the whole value
+type MaskFunc func(value string) string
+
+// Builtin mask policies
+const (
+	// MaskFull masks all characters
+	MaskFull = "full"
+	// MaskLast4 keeps the last 4 characters, e.g. bank card number
+	MaskLast4 = "last4"
+	// MaskFirst3Last4 keeps the first 3 and last 4 characters, e.g. phone number 138****8000
+	MaskFirst3Last4 = "first3last4"
+	// MaskEmail keeps the first character of local-part and the domain, e.g. a****@example.com
+	MaskEmail = "email"
+	// MaskName keeps the first character, e.g. 张**
+	MaskName = "name"
+)
+
+var maskPolicies sync.Map
+
+func init() {
+	RegisterMaskPolicy(MaskFull, func(value string) string {
+		return maskRunes(value, 0, 0)
+	})
+	RegisterMaskPolicy(MaskLast4, func(value string) string {
+		return maskRunes(value, 0, 4)
+	})
+	RegisterMaskPolicy(MaskFirst3Last4, func(value string) string {
+		return maskRunes(value, 3, 4)
+	})
+	RegisterMaskPolicy(MaskEmail, maskEmail)
+	RegisterMaskPolicy(MaskName, func(value string) string {
+		return maskRunes(value, 1, 0)
+	})
+}
+
+// RegisterMaskPolicy registers named mask policy, replaces the existing one with the same name
+func RegisterMaskPolicy(name string, fn MaskFunc) {
+	maskPolicies.Store(name, fn)
+}
+
+// Mask masks value with named policy
+func Mask(value string, policy string) (string, error) {
+	fn, ok := maskPolicies.Load(policy)
+	if !ok {
+		return "", ErrUnknownMaskPolicy
+	}
+	return fn.(MaskFunc)(value), nil
+}
+
+// DecryptMasked decrypt content like DecryptWithContext and returns plaintext masked by named policy,
+// for callers that should only see partial values.
+func (sv *KeyService) DecryptMasked(content string, keyID string, context map[string]string, policy string) (string, error) {
+	// 先检查策略，避免无效请求解密数据
+	if _, ok := maskPolicies.Load(policy); !ok {
+		return "", ErrUnknownMaskPolicy
+	}
+
+	text, err := sv.DecryptWithContext(content, keyID, context)
+	if err != nil {
+		return "", err
+	}
+
+	return Mask(text, policy)
+}
+
+// DecryptBytesMasked is like DecryptMasked, decrypts raw envelope bytes generated by EncryptBytesWithContext
+func (sv *KeyService) DecryptBytesMasked(content []byte, keyID string, context map[string]string, policy string) (string, error) {
+	if _, ok := maskPolicies.Load(policy); !ok {
+		return "", ErrUnknownMaskPolicy
+	}
+
+	text, err := sv.DecryptBytesWithContext(content, keyID, context)
+	if err != nil {
+		return "", err
+	}
+
+	return Mask(string(text), policy)
+}
+
+// maskRunes keeps the first `head` and the last `tail` characters,
+// keeps fewer characters if value is too short so that at least one third of it is masked.
+func maskRunes(value string, head, tail int) string {
+	rs := []rune(value)
+	for head+tail > 0 && head+tail > len(rs)*2/3 {
+		if tail >= head {
+			tail--
+		} else {
+			head--
+		}
+	}
+	for i := head; i < len(rs)-tail; i++ {
+		rs[i] = maskChar
+	}
+	return string(rs)
+}
+
+func maskEmail(value string) string {
+	at := strings.LastIndexByte(value, '@')
+	if at <= 0 {
+		return maskRunes(value, 0, 0)
+	}
+
+	local := []rune(value[:at])
+	for i := 1; i < len(local); i++ {
+		local[i] = maskChar
+	}
+	if len(local) == 1 {
+		local[0] = maskChar
+	}
+
+	return string(local) + value[at:]
+}
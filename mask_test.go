@@ -0,0 +1,75 @@
+package keyservice
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMask(t *testing.T) {
+	cases := []struct {
+		policy, value, masked string
+	}{
+		{MaskFull, "13800138000", "***********"},
+		{MaskFull, "", ""},
+		{MaskLast4, "6222020200112233445", "***************3445"},
+		{MaskLast4, "12345678", "****5678"},
+		{MaskLast4, "123456", "**3456"},
+		{MaskLast4, "1", "*"},
+		{MaskFirst3Last4, "13800138000", "138****8000"},
+		{MaskFirst3Last4, "1234567", "12***67"},
+		{MaskEmail, "alice@example.com", "a****@example.com"},
+		{MaskEmail, "a@example.com", "*@example.com"},
+		{MaskEmail, "not-an-email", "************"},
+		{MaskEmail, "@example.com", "************"},
+		{MaskName, "张三丰", "张**"},
+		{MaskName, "张三", "张*"},
+		{MaskName, "张", "*"},
+	}
+
+	for _, c := range cases {
+		masked, err := Mask(c.value, c.policy)
+		require.NoError(t, err)
+		assert.Equal(t, c.masked, masked, "policy=%s value=%s", c.policy, c.value)
+	}
+
+	_, err := Mask("13800138000", "not-exists")
+	assert.Equal(t, ErrUnknownMaskPolicy, err)
+
+	RegisterMaskPolicy("test-upper", func(value string) string {
+		return strings.ToUpper(value[:1]) + "***"
+	})
+	masked, err := Mask("abc", "test-upper")
+	require.NoError(t, err)
+	assert.Equal(t, "A***", masked)
+}
+
+func TestDecryptMasked(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	context := map[string]string{"column": "user.phone"}
+	encrypted, err := sv.EncryptWithContext("13800138000", _testKeyId2, context)
+	require.NoError(t, err)
+
+	masked, err := sv.DecryptMasked(encrypted, _testKeyId2, context, MaskFirst3Last4)
+	require.NoError(t, err)
+	assert.Equal(t, "138****8000", masked)
+
+	_, err = sv.DecryptMasked(encrypted, _testKeyId2, context, "not-exists")
+	assert.Equal(t, ErrUnknownMaskPolicy, err)
+	_, err = sv.DecryptMasked(encrypted, _testKeyId2, nil, MaskLast4)
+	assert.Error(t, err)
+
+	raw, err := sv.EncryptBytesWithContext([]byte("13800138000"), _testKeyId2, context)
+	require.NoError(t, err)
+	masked, err = sv.DecryptBytesMasked(raw, _testKeyId2, context, MaskLast4)
+	require.NoError(t, err)
+	assert.Equal(t, "*******8000", masked)
+	_, err = sv.DecryptBytesMasked(raw, _testKeyId2, context, "not-exists")
+	assert.Equal(t, ErrUnknownMaskPolicy, err)
+	// policy is checked before decryption
+	_, err = sv.DecryptBytesMasked([]byte("invalid"), _testKeyId2, context, "not-exists")
+	assert.Equal(t, ErrUnknownMaskPolicy, err)
+}
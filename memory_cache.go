@@ -0,0 +1,142 @@
+package keyservice
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// MemoryCacheOptions are options of in-process memory cache of keys
+type MemoryCacheOptions struct {
+	// 最大缓存数量，超出时淘汰最久未使用的
+	Size int
+	// 缓存在内存中的最长时间，过期后从Cache(如文件缓存)或storage重新加载
+	TTL time.Duration
+	// 不存在的key的缓存时间，避免反复查询storage，0表示不缓存
+	NegativeTTL time.Duration
+}
+
+// DefaultMemoryCacheOptions is used by NewKeyService
+var DefaultMemoryCacheOptions = MemoryCacheOptions{
+	Size:        10000,
+	TTL:         10 * time.Minute,
+	NegativeTTL: 10 * time.Second,
+}
+
+// CacheStats is statistics of memory cache
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64 // entries evicted by size limit or TTL
+	Size      int
+}
+
+// memoryCache is LRU cache of cache items with per-entry TTL, it's safe for concurrent use.
+// Item with nil value is negative entry of key not found.
+type memoryCache struct {
+	opts MemoryCacheOptions
+
+	mu      sync.Mutex
+	ll      *list.List
+	entries map[string]*list.Element
+	stats   CacheStats
+}
+
+type memoryCacheEntry struct {
+	id        string
+	item      *cacheItem
+	expiresAt time.Time
+}
+
+func newMemoryCache(opts MemoryCacheOptions) *memoryCache {
+	return &memoryCache{
+		opts:    opts,
+		ll:      list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns unexpired item of id
+func (c *memoryCache) Get(id string) (*cacheItem, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[id]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+
+	entry := el.Value.(*memoryCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(el)
+		c.stats.Evictions++
+		c.stats.Misses++
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	c.stats.Hits++
+
+	return entry.item, true
+}
+
+// Set stores item of id, negative item(nil value) uses NegativeTTL
+func (c *memoryCache) Set(id string, item *cacheItem) {
+	ttl := c.opts.TTL
+	if item.Value == nil {
+		ttl = c.opts.NegativeTTL
+	}
+	if c.opts.Size <= 0 || ttl <= 0 {
+		c.Delete(id)
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(ttl)
+	if el, ok := c.entries[id]; ok {
+		entry := el.Value.(*memoryCacheEntry)
+		entry.item = item
+		entry.expiresAt = expiresAt
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.entries[id] = c.ll.PushFront(&memoryCacheEntry{
+		id:        id,
+		item:      item,
+		expiresAt: expiresAt,
+	})
+
+	for c.ll.Len() > c.opts.Size {
+		c.remove(c.ll.Back())
+		c.stats.Evictions++
+	}
+}
+
+// Delete removes item of id
+func (c *memoryCache) Delete(id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[id]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *memoryCache) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.entries, el.Value.(*memoryCacheEntry).id)
+}
+
+// Stats returns statistics of cache
+func (c *memoryCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Size = c.ll.Len()
+	return stats
+}
@@ -0,0 +1,76 @@
+package keyservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := newMemoryCache(MemoryCacheOptions{Size: 2, TTL: time.Hour, NegativeTTL: 10 * time.Millisecond})
+
+	c.Set("a", &cacheItem{Value: _testKey1})
+	c.Set("b", &cacheItem{Value: _testKey2})
+	_, ok := c.Get("a")
+	assert.True(t, ok)
+
+	// b is the least recently used
+	c.Set("c", &cacheItem{Value: _testKey1})
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+
+	// negative entry expires with NegativeTTL
+	c.Set("d", &cacheItem{})
+	item, ok := c.Get("d")
+	assert.True(t, ok)
+	assert.Nil(t, item.Value)
+	time.Sleep(20 * time.Millisecond)
+	_, ok = c.Get("d")
+	assert.False(t, ok)
+
+	c.Delete("a")
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(3), stats.Hits)
+	assert.Equal(t, uint64(3), stats.Misses)
+	assert.Equal(t, uint64(3), stats.Evictions)
+	assert.Equal(t, 0, stats.Size)
+
+	// disabled
+	c = newMemoryCache(MemoryCacheOptions{})
+	c.Set("a", &cacheItem{Value: _testKey1})
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+}
+
+func TestServiceNegativeCache(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, NoCache)
+	sv.SetMemoryCache(MemoryCacheOptions{Size: 10, TTL: time.Hour, NegativeTTL: time.Hour})
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, sv.GetKey("key-not-exist"))
+	}
+	assert.Equal(t, 1, s.loadStat["key-not-exist"])
+
+	// created key replaces negative entry
+	key, err := sv.CreateKey("key-not-exist", KeyOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, key, sv.GetKey("key-not-exist"))
+
+	// storage change event removes negative entry
+	assert.Nil(t, sv.GetKey("key-created-later"))
+	s.Store("key-created-later", _testKey1)
+	assert.Nil(t, sv.GetKey("key-created-later"))
+	sv.cache.Expire("key-created-later")
+	assert.NotNil(t, sv.GetKey("key-created-later"))
+
+	stats := sv.CacheStats()
+	assert.True(t, stats.Hits > 0)
+	assert.True(t, stats.Misses > 0)
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/techxmind/keyservice"
 	"github.com/techxmind/logger"
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/metrics"
@@ -107,7 +108,10 @@ func RequestMetrics(label string, in endpoint.Endpoint) endpoint.Endpoint {
 		}
 
 		var clientIp = ""
-		if v, ok := ctx.Value("remote-ip").(string); ok {
+		if caller := keyservice.CallerFromContext(ctx); caller != "" {
+			// authenticated identity is more meaningful than address
+			clientIp = caller
+		} else if v, ok := ctx.Value("remote-ip").(string); ok {
 			clientIp = v
 		} else if p, ok := peer.FromContext(ctx); ok {
 			clientIp = p.Addr.String()
@@ -149,6 +153,85 @@ func AddRequest(client, method string, code int32, du time.Duration) {
 	).Observe(du.Seconds())
 }
 
+// RegisterCacheStats exports statistics of key cache, e.g. RegisterCacheStats(ks.CacheStats)
+func RegisterCacheStats(stats func() keyservice.CacheStats) {
+	counters := []struct {
+		name  string
+		help  string
+		value func(s keyservice.CacheStats) float64
+	}{
+		{"cache_hits", "keyserver key cache hits", func(s keyservice.CacheStats) float64 { return float64(s.Hits) }},
+		{"cache_misses", "keyserver key cache misses", func(s keyservice.CacheStats) float64 { return float64(s.Misses) }},
+		{"cache_evictions", "keyserver key cache evictions by size limit or TTL", func(s keyservice.CacheStats) float64 { return float64(s.Evictions) }},
+	}
+	for _, c := range counters {
+		value := c.value
+		stdprometheus.MustRegister(stdprometheus.NewCounterFunc(stdprometheus.CounterOpts{
+			Namespace:   "keyserver",
+			Name:        c.name,
+			Help:        c.help,
+			ConstLabels: stdprometheus.Labels{"server": _hostname},
+		}, func() float64 {
+			return value(stats())
+		}))
+	}
+
+	stdprometheus.MustRegister(stdprometheus.NewGaugeFunc(stdprometheus.GaugeOpts{
+		Namespace:   "keyserver",
+		Name:        "cache_size",
+		Help:        "keyserver key cache size",
+		ConstLabels: stdprometheus.Labels{"server": _hostname},
+	}, func() float64 {
+		return float64(stats().Size)
+	}))
+}
+
+// RegisterHealth exports health status of key service, e.g. RegisterHealth(ks.Health)
+func RegisterHealth(health func() keyservice.Health) {
+	bool2float := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	labels := stdprometheus.Labels{"server": _hostname}
+
+	stdprometheus.MustRegister(
+		stdprometheus.NewGaugeFunc(stdprometheus.GaugeOpts{
+			Namespace:   "keyserver",
+			Name:        "degraded",
+			Help:        "keyserver serves cached keys because storage is unavailable",
+			ConstLabels: labels,
+		}, func() float64 {
+			return bool2float(health().Degraded)
+		}),
+		stdprometheus.NewGaugeFunc(stdprometheus.GaugeOpts{
+			Namespace:   "keyserver",
+			Name:        "storage_circuit_open",
+			Help:        "keyserver stops accessing storage after consecutive failures",
+			ConstLabels: labels,
+		}, func() float64 {
+			return bool2float(health().CircuitOpen)
+		}),
+		stdprometheus.NewCounterFunc(stdprometheus.CounterOpts{
+			Namespace:   "keyserver",
+			Name:        "storage_errors",
+			Help:        "keyserver storage load errors",
+			ConstLabels: labels,
+		}, func() float64 {
+			return float64(health().StorageErrors)
+		}),
+		stdprometheus.NewCounterFunc(stdprometheus.CounterOpts{
+			Namespace:   "keyserver",
+			Name:        "stale_keys_served",
+			Help:        "keyserver cached keys served during storage errors",
+			ConstLabels: labels,
+		}, func() float64 {
+			return float64(health().StaleKeysServed)
+		}),
+	)
+}
+
 func CounterAdd(action string, delta float64) {
 	_actionCounter.With(
 		"server", _hostname,
@@ -0,0 +1,93 @@
+package keyservice
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+)
+
+// Operation is the kind of operations authorized by Policy
+type Operation string
+
+const (
+	// OperationEncrypt encrypts data, generates data keys, blind indexes and tokens
+	OperationEncrypt Operation = "encrypt"
+	// OperationDecrypt decrypts data, unwraps data keys and detokenizes tokens, implies OperationDecryptMasked
+	OperationDecrypt Operation = "decrypt"
+	// OperationDecryptMasked decrypts data with mask policy, only masked plaintext is returned
+	OperationDecryptMasked Operation = "decrypt:masked"
+	// OperationManage creates, rotates, disables, deletes keys and reads key values
+	OperationManage Operation = "manage"
+)
+
+// Policy allows callers to do operations with keys.
+// Callers and keys are patterns of path.Match, e.g. "cert:order-*", "user.*", "*" matches all.
+type Policy struct {
+	Callers    []string    `json:"callers"`
+	Keys       []string    `json:"keys"`
+	Operations []Operation `json:"operations"`
+}
+
+// Policies is a list of Policy, an operation is allowed if any policy allows it
+type Policies []*Policy
+
+// LoadPolicies loads policies from json file, e.g.
+//
+//	[{"callers": ["cert:order-service"], "keys": ["order.*"], "operations": ["encrypt", "decrypt"]}]
+func LoadPolicies(file string) (Policies, error) {
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var ps Policies
+	if err = json.Unmarshal(contents, &ps); err != nil {
+		return nil, err
+	}
+
+	// 提前检查模式是否合法
+	for _, p := range ps {
+		for _, pattern := range append(append([]string{}, p.Callers...), p.Keys...) {
+			if _, err = path.Match(pattern, ""); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return ps, nil
+}
+
+// Allowed reports whether caller can do op with key specified by keyID,
+// keyID is empty for operations not on a single key(e.g. list keys), only "*" matches it.
+func (ps Policies) Allowed(caller string, keyID string, op Operation) bool {
+	for _, p := range ps {
+		if p.allowed(caller, keyID, op) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) allowed(caller string, keyID string, op Operation) bool {
+	hasOp := false
+	for _, o := range p.Operations {
+		if o == op || (o == OperationDecrypt && op == OperationDecryptMasked) {
+			hasOp = true
+			break
+		}
+	}
+
+	return hasOp && matchAny(p.Callers, caller) && matchAny(p.Keys, keyID)
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if pattern == "*" {
+			return true
+		}
+		if matched, _ := path.Match(pattern, name); matched && name != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package keyservice
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicies(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-policy-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	defer os.Remove(file)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`[
+		{"callers": ["cert:order-service"], "keys": ["order.*"], "operations": ["encrypt", "decrypt"]},
+		{"callers": ["token:report-*"], "keys": ["order.*", "user.phone"], "operations": ["encrypt"]},
+		{"callers": ["cert:admin"], "keys": ["*"], "operations": ["manage"]},
+		{"callers": ["cert:support"], "keys": ["user.*"], "operations": ["decrypt:masked"]}
+	]`), 0600))
+
+	ps, err := LoadPolicies(file)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(ps))
+
+	cases := []struct {
+		caller, keyID string
+		op            Operation
+		allowed       bool
+	}{
+		{"cert:order-service", "order.address", OperationEncrypt, true},
+		{"cert:order-service", "order.address", OperationDecrypt, true},
+		{"cert:order-service", "order.address", OperationManage, false},
+		{"cert:order-service", "user.phone", OperationDecrypt, false},
+		{"cert:order-service-2", "order.address", OperationDecrypt, false},
+		{"token:report-daily", "order.address", OperationEncrypt, true},
+		{"token:report-daily", "user.phone", OperationEncrypt, true},
+		{"token:report-daily", "user.phone", OperationDecrypt, false},
+		{"cert:admin", "user.phone", OperationManage, true},
+		{"cert:admin", "", OperationManage, true},
+		{"cert:admin", "user.phone", OperationDecrypt, false},
+		{"cert:order-service", "", OperationEncrypt, false},
+		{"", "order.address", OperationEncrypt, false},
+		{"cert:order-service", "order.address", OperationDecryptMasked, true},
+		{"cert:support", "user.phone", OperationDecryptMasked, true},
+		{"cert:support", "user.phone", OperationDecrypt, false},
+		{"cert:support", "order.address", OperationDecryptMasked, false},
+		{"token:report-daily", "user.phone", OperationDecryptMasked, false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.allowed, ps.Allowed(c.caller, c.keyID, c.op), "%+v", c)
+	}
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`[{"callers": ["["], "keys": ["*"], "operations": ["encrypt"]}]`), 0600))
+	_, err = LoadPolicies(file)
+	assert.Error(t, err)
+}
@@ -0,0 +1,122 @@
+package keyservice
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// RefreshOptions are options of refreshing cached keys from storage
+type RefreshOptions struct {
+	// 过期后仍直接使用的时间，期间在后台刷新；超过后请求等待刷新完成，0表示总是等待
+	MaxStaleness time.Duration
+	// 过期前提前在后台刷新的时间，0表示不提前刷新
+	RefreshAhead time.Duration
+}
+
+// DefaultRefreshOptions is used by NewKeyService
+var DefaultRefreshOptions = RefreshOptions{
+	MaxStaleness: 5 * time.Minute,
+	RefreshAhead: 10 * time.Second,
+}
+
+// refresher loads keys from storage, concurrent loads of the same keys are deduplicated
+type refresher struct {
+	mu         sync.Mutex
+	opts       RefreshOptions
+	refreshing map[string]struct{}
+	wg         sync.WaitGroup
+
+	group singleflight.Group
+}
+
+func newRefresher(opts RefreshOptions) *refresher {
+	return &refresher{
+		opts:       opts,
+		refreshing: make(map[string]struct{}),
+	}
+}
+
+func (r *refresher) options() RefreshOptions {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.opts
+}
+
+// SetRefreshOptions sets options of refreshing cached keys
+func (sv *KeyService) SetRefreshOptions(opts RefreshOptions) {
+	sv.refresher.mu.Lock()
+	sv.refresher.opts = opts
+	sv.refresher.mu.Unlock()
+}
+
+// loadKeys loads keys from storage and updates cache,
+// callers loading the same keys at the same time share one storage call.
+// It returns ErrStorageUnavailable without calling storage if the circuit is open.
+func (sv *KeyService) loadKeys(ids []string) (map[string]*Key, error) {
+	v, err, _ := sv.refresher.group.Do(strings.Join(ids, "\x00"), func() (interface{}, error) {
+		if !sv.breaker.allow() {
+			return nil, ErrStorageUnavailable
+		}
+
+		logger.Debugf("load keys=%s from storage", strings.Join(ids, ","))
+		keys, err := sv.storage.LoadMany(ids)
+		if err != nil {
+			logger.Errorf("load keys=%s from storage err=%v", strings.Join(ids, ","), err)
+			sv.breaker.failure(err)
+			return nil, err
+		}
+		sv.breaker.success()
+
+		for _, id := range ids {
+			if key, ok := keys[id]; ok {
+				sv.cache.Store(id, key)
+				continue
+			}
+			if key, _ := sv.cache.Load(id); key != nil {
+				// key has been deleted from storage
+				sv.cache.Delete(id)
+			}
+			sv.cache.StoreNotFound(id)
+		}
+
+		return keys, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[string]*Key), nil
+}
+
+// refreshAsync loads keys in background, keys being refreshed are skipped
+func (sv *KeyService) refreshAsync(ids []string) {
+	r := sv.refresher
+
+	r.mu.Lock()
+	refreshIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := r.refreshing[id]; !ok {
+			r.refreshing[id] = struct{}{}
+			refreshIDs = append(refreshIDs, id)
+		}
+	}
+	r.mu.Unlock()
+
+	if len(refreshIDs) == 0 {
+		return
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		sv.loadKeys(refreshIDs)
+
+		r.mu.Lock()
+		for _, id := range refreshIDs {
+			delete(r.refreshing, id)
+		}
+		r.mu.Unlock()
+	}()
+}
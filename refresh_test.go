@@ -0,0 +1,82 @@
+package keyservice
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// slowStorage counts LoadMany calls and delays them
+type slowStorage struct {
+	*testStorage
+	mu    sync.Mutex
+	loads int32
+	delay time.Duration
+}
+
+func (s *slowStorage) LoadMany(ids []string) (map[string]*Key, error) {
+	atomic.AddInt32(&s.loads, 1)
+	time.Sleep(s.delay)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.testStorage.LoadMany(ids)
+}
+
+func TestServiceStaleWhileRevalidate(t *testing.T) {
+	s := &slowStorage{testStorage: newTestStorage(), delay: 50 * time.Millisecond}
+	sv := NewKeyService("seed-key", s, NoCache)
+	sv.SetRefreshOptions(RefreshOptions{MaxStaleness: time.Hour})
+
+	assert.NotNil(t, sv.GetKey(_testKeyId1))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&s.loads))
+
+	// expired key is served without waiting for storage, and refreshed once in background
+	sv.cache.storeItem(_testKeyId1, &cacheItem{Value: _testKey1, Expiration: time.Now().Add(-time.Second)})
+	begin := time.Now()
+	for i := 0; i < 10; i++ {
+		assert.NotNil(t, sv.GetKey(_testKeyId1))
+	}
+	assert.True(t, time.Since(begin) < s.delay)
+	sv.refresher.wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&s.loads))
+	_, err := sv.cache.Load(_testKeyId1)
+	assert.NoError(t, err)
+
+	// key beyond max staleness waits for storage, concurrent callers share one load
+	sv.cache.storeItem(_testKeyId1, &cacheItem{Value: _testKey1, Expiration: time.Now().Add(-2 * time.Hour)})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NotNil(t, sv.GetKey(_testKeyId1))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(3), atomic.LoadInt32(&s.loads))
+	_, err = sv.cache.Load(_testKeyId1)
+	assert.NoError(t, err)
+}
+
+func TestServiceRefreshAhead(t *testing.T) {
+	s := &slowStorage{testStorage: newTestStorage()}
+	sv := NewKeyService("seed-key", s, NoCache)
+	sv.SetRefreshOptions(RefreshOptions{RefreshAhead: time.Minute})
+
+	assert.NotNil(t, sv.GetKey(_testKeyId1))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&s.loads))
+
+	// key expires soon
+	expiration := time.Now().Add(time.Second)
+	sv.cache.storeItem(_testKeyId1, &cacheItem{Value: _testKey1, Expiration: expiration})
+	assert.NotNil(t, sv.GetKey(_testKeyId1))
+	sv.refresher.wg.Wait()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&s.loads))
+
+	item, err := sv.cache.LoadItem(_testKeyId1)
+	assert.NoError(t, err)
+	assert.True(t, item.Expiration.After(expiration))
+}
@@ -0,0 +1,139 @@
+package keyservice
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrStorageUnavailable = errors.New("Storage unavailable")
+
+// ResilienceOptions are options of serving keys when storage fails
+type ResilienceOptions struct {
+	// storage故障时缓存的key(最近一次加载成功的副本)可继续使用的最长时间，0表示不限制
+	MaxKeyAge time.Duration
+	// 连续失败次数达到后断开，期间不再访问storage，0表示不断开
+	FailureThreshold int
+	// 断开后等待多久再尝试访问storage
+	OpenTimeout time.Duration
+}
+
+// DefaultResilienceOptions is used by NewKeyService
+var DefaultResilienceOptions = ResilienceOptions{
+	MaxKeyAge:        24 * time.Hour,
+	FailureThreshold: 5,
+	OpenTimeout:      10 * time.Second,
+}
+
+// Health is status of KeyService, it's degraded if the last storage load failed,
+// and cached keys are served as last known good copies.
+type Health struct {
+	Degraded      bool      `json:"degraded"`
+	DegradedSince time.Time `json:"degraded_since,omitempty"`
+	CircuitOpen   bool      `json:"circuit_open"`
+	LastError     string    `json:"last_error,omitempty"`
+	// 累计storage加载失败次数(不含断开期间被拒绝的)
+	StorageErrors uint64 `json:"storage_errors"`
+	// 累计storage故障时使用缓存key的次数
+	StaleKeysServed uint64 `json:"stale_keys_served"`
+}
+
+// storageBreaker is circuit breaker of storage loads, it also keeps health status.
+// After FailureThreshold consecutive failures it opens for OpenTimeout, then lets one trial load through,
+// the circuit closes if the trial succeeds, or opens again.
+type storageBreaker struct {
+	mu       sync.Mutex
+	opts     ResilienceOptions
+	failures int
+	openedAt time.Time
+	trial    bool
+	health   Health
+}
+
+func newStorageBreaker(opts ResilienceOptions) *storageBreaker {
+	return &storageBreaker{opts: opts}
+}
+
+func (b *storageBreaker) options() ResilienceOptions {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.opts
+}
+
+// allow reports whether storage can be accessed
+func (b *storageBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.health.CircuitOpen {
+		return true
+	}
+	if b.trial || time.Since(b.openedAt) < b.opts.OpenTimeout {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+func (b *storageBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.health.Degraded {
+		logger.Infof("storage recovered, degraded since %s", b.health.DegradedSince.Format(time.RFC3339))
+	}
+	b.failures = 0
+	b.trial = false
+	b.health.Degraded = false
+	b.health.DegradedSince = time.Time{}
+	b.health.CircuitOpen = false
+	b.health.LastError = ""
+}
+
+func (b *storageBreaker) failure(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	b.health.StorageErrors++
+	b.health.LastError = err.Error()
+	if !b.health.Degraded {
+		b.health.Degraded = true
+		b.health.DegradedSince = time.Now()
+	}
+
+	if b.trial || (b.opts.FailureThreshold > 0 && b.failures >= b.opts.FailureThreshold) {
+		if !b.health.CircuitOpen {
+			logger.Errorf("storage circuit open after %d failures, err=%v", b.failures, err)
+		}
+		b.trial = false
+		b.health.CircuitOpen = true
+		b.openedAt = time.Now()
+	}
+}
+
+func (b *storageBreaker) staleServed(n int) {
+	b.mu.Lock()
+	b.health.StaleKeysServed += uint64(n)
+	b.mu.Unlock()
+}
+
+// SetResilienceOptions sets options of serving keys when storage fails
+func (sv *KeyService) SetResilienceOptions(opts ResilienceOptions) {
+	sv.breaker.mu.Lock()
+	sv.breaker.opts = opts
+	sv.breaker.mu.Unlock()
+}
+
+// Health returns health status of KeyService
+func (sv *KeyService) Health() Health {
+	sv.breaker.mu.Lock()
+	defer sv.breaker.mu.Unlock()
+	return sv.breaker.health
+}
+
+// usableWhenStorageFails reports whether cached item can be served during storage errors
+func (sv *KeyService) usableWhenStorageFails(item *cacheItem, now time.Time) bool {
+	maxAge := sv.breaker.options().MaxKeyAge
+	return maxAge <= 0 || (!item.LoadedAt.IsZero() && now.Sub(item.LoadedAt) <= maxAge)
+}
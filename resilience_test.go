@@ -0,0 +1,106 @@
+package keyservice
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// failingStorage fails all loads when down
+type failingStorage struct {
+	*testStorage
+	down  int32
+	loads int32
+}
+
+func (s *failingStorage) LoadMany(ids []string) (map[string]*Key, error) {
+	atomic.AddInt32(&s.loads, 1)
+	if atomic.LoadInt32(&s.down) == 1 {
+		return nil, errors.New("storage down")
+	}
+	return s.testStorage.LoadMany(ids)
+}
+
+func TestServiceStorageOutage(t *testing.T) {
+	s := &failingStorage{testStorage: newTestStorage()}
+	sv := NewKeyService("seed-key", s, NoCache)
+	sv.SetRefreshOptions(RefreshOptions{})
+	sv.SetResilienceOptions(ResilienceOptions{
+		MaxKeyAge:        time.Hour,
+		FailureThreshold: 3,
+		OpenTimeout:      50 * time.Millisecond,
+	})
+
+	keys, err := sv.GetKeys([]string{_testKeyId1, _testKeyId2})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.False(t, sv.Health().Degraded)
+
+	// key1 was loaded recently, key2 is older than max key age
+	sv.cache.storeItem(_testKeyId1, &cacheItem{Value: _testKey1, LoadedAt: time.Now().Add(-time.Minute)})
+	sv.cache.storeItem(_testKeyId2, &cacheItem{Value: _testKey2, LoadedAt: time.Now().Add(-2 * time.Hour)})
+	atomic.StoreInt32(&s.down, 1)
+
+	for i := 0; i < 3; i++ {
+		keys, err = sv.GetKeys([]string{_testKeyId1, _testKeyId2})
+		assert.Error(t, err)
+		assert.Equal(t, _testKey1, keys[_testKeyId1])
+		assert.Nil(t, keys[_testKeyId2])
+	}
+	health := sv.Health()
+	assert.True(t, health.Degraded)
+	assert.True(t, health.CircuitOpen)
+	assert.Equal(t, uint64(3), health.StorageErrors)
+	assert.Equal(t, uint64(3), health.StaleKeysServed)
+	assert.Equal(t, "storage down", health.LastError)
+
+	// circuit is open, storage is not accessed
+	loads := atomic.LoadInt32(&s.loads)
+	keys, err = sv.GetKeys([]string{_testKeyId1})
+	assert.Equal(t, ErrStorageUnavailable, err)
+	assert.Equal(t, _testKey1, keys[_testKeyId1])
+	assert.Equal(t, loads, atomic.LoadInt32(&s.loads))
+
+	// keys without usable cached copy are unavailable, not missing
+	encrypted, err := sv.Encrypt("hello", _testKeyId1)
+	assert.NoError(t, err)
+	decrypted, err := sv.Decrypt(encrypted, _testKeyId1)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", decrypted)
+	_, err = sv.Encrypt("hello", _testKeyId2)
+	assert.Equal(t, ErrStorageUnavailable, err)
+	_, err = sv.Decrypt(encrypted, _testKeyId2)
+	assert.Equal(t, ErrStorageUnavailable, err)
+	_, err = sv.Decrypt("invalid-base64!", _testKeyId2)
+	assert.Equal(t, ErrStorageUnavailable, err)
+	_, err = sv.LookupKey("key-not-cached")
+	assert.Equal(t, ErrStorageUnavailable, err)
+	assert.Nil(t, sv.GetKey(_testKeyId2))
+	assert.Equal(t, loads, atomic.LoadInt32(&s.loads))
+
+	// failed trial opens the circuit again
+	time.Sleep(60 * time.Millisecond)
+	_, err = sv.GetKeys([]string{_testKeyId1})
+	assert.EqualError(t, err, "storage down")
+	assert.Equal(t, loads+1, atomic.LoadInt32(&s.loads))
+	_, err = sv.GetKeys([]string{_testKeyId1})
+	assert.Equal(t, ErrStorageUnavailable, err)
+
+	// successful trial closes the circuit
+	atomic.StoreInt32(&s.down, 0)
+	time.Sleep(60 * time.Millisecond)
+	keys, err = sv.GetKeys([]string{_testKeyId1, _testKeyId2})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	health = sv.Health()
+	assert.False(t, health.Degraded)
+	assert.False(t, health.CircuitOpen)
+
+	_, err = sv.LookupKey("key-not-exists")
+	assert.Equal(t, ErrNotFound, err)
+	_, err = sv.Encrypt("hello", "key-not-exists")
+	assert.Equal(t, ErrNotFound, err)
+}
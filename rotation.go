@@ -0,0 +1,226 @@
+package keyservice
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"hash/fnv"
+	"math/rand"
+	"time"
+)
+
+var (
+	ErrVersionOverflow    = errors.New("Key version overflow")
+	ErrStorageNotIterable = errors.New("Storage can't list keys")
+
+	errRotationNotDue = errors.New("rotation not due")
+)
+
+var (
+	// 新生成密钥的字节数
+	keyValueSize = 32
+	// 默认自动轮换检查间隔
+	rotationCheckInterval = 1 * time.Hour
+	// 并发修改冲突时的最大尝试次数
+	maxUpdateAttempts = 16
+)
+
+// keyLockCount is the number of mutexes serializing updates of keys within the process
+const keyLockCount = 64
+
+// generateKeyValue returns new random key value
+func generateKeyValue() (string, error) {
+	bs, err := randomBytes(keyValueSize)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+// loadKey loads key from storage directly, bypass cache
+func (sv *KeyService) loadKey(id string) (*Key, error) {
+	keys, err := sv.storage.LoadMany([]string{id})
+	if err != nil {
+		return nil, err
+	}
+	key := keys[id]
+	if key == nil {
+		return nil, ErrNotFound
+	}
+	return key.Copy(), nil
+}
+
+// compareAndStoreKey persists key only if the stored key is still old, nil old means absent,
+// and refreshes cache. Storage not implementing ConditionalStorage stores key unconditionally.
+func (sv *KeyService) compareAndStoreKey(id string, old, key *Key) error {
+	if storage, ok := sv.storage.(ConditionalStorage); ok {
+		if err := storage.CompareAndStore(id, old, key); err != nil {
+			return err
+		}
+	} else if err := sv.storage.Store(id, key); err != nil {
+		return err
+	}
+	sv.cache.Store(id, key)
+	return nil
+}
+
+// lockKey serializes updates of key id within the process, returns the unlock function
+func (sv *KeyService) lockKey(id string) func() {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	mu := &sv.keyLocks[h.Sum32()%keyLockCount]
+	mu.Lock()
+	return mu.Unlock
+}
+
+// updateKey applies fn to the stored key and writes it back. If the key is modified concurrently
+// by other instances, fn is applied again on the latest key.
+func (sv *KeyService) updateKey(id string, fn func(key *Key) error) (*Key, error) {
+	defer sv.lockKey(id)()
+
+	for attempt := 1; ; attempt++ {
+		old, err := sv.loadKey(id)
+		if err != nil {
+			return nil, err
+		}
+		key := old.Copy()
+		if err = fn(key); err != nil {
+			return nil, err
+		}
+		err = sv.compareAndStoreKey(id, old, key)
+		if err == nil {
+			return key, nil
+		}
+		if err != ErrConflict || attempt >= maxUpdateAttempts {
+			return nil, err
+		}
+		logger.Debugf("update key=%s conflict, attempt=%d", id, attempt)
+		time.Sleep(time.Duration(rand.Int63n(int64(attempt) * int64(time.Millisecond))))
+	}
+}
+
+// RotateKey generates new key value as the current version,
+// the previous version is demoted to decrypt-only.
+func (sv *KeyService) RotateKey(id string) (*Key, error) {
+	return sv.rotate(id, func(key *Key) error {
+		if !key.Enabled() {
+			return ErrKeyDisabled
+		}
+		return nil
+	})
+}
+
+// rotate rotates key if check passes, check is done again on the latest key when the key
+// is modified concurrently
+func (sv *KeyService) rotate(id string, check func(key *Key) error) (*Key, error) {
+	key, err := sv.updateKey(id, func(key *Key) error {
+		if err := check(key); err != nil {
+			return err
+		}
+		return rotateVersion(key)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Infof("rotate key=%s version=%d", id, key.Version)
+
+	return key, nil
+}
+
+// rotateVersion appends new active version to key
+func rotateVersion(key *Key) error {
+	var version uint16
+	for _, v := range key.Versions {
+		if v.Version > version {
+			version = v.Version
+		}
+	}
+	if version == ^uint16(0) {
+		return ErrVersionOverflow
+	}
+	version++
+
+	value, err := generateKeyValue()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	if v := key.Current(); v != nil && v.State == KeyStateActive {
+		v.State = KeyStateDecryptOnly
+		v.UpdatedAt = now
+	}
+	key.Version = version
+	key.Versions = append(key.Versions, &KeyVersion{
+		Version:   version,
+		Value:     value,
+		State:     KeyStateActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+
+	return nil
+}
+
+// RotateDueKeys rotates keys whose rotation period has elapsed, returns ids of rotated keys
+func (sv *KeyService) RotateDueKeys() (rotated []string, err error) {
+	storage, ok := sv.storage.(IterableStorage)
+	if !ok {
+		return nil, ErrStorageNotIterable
+	}
+
+	ids, err := storage.IDs()
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	for _, id := range ids {
+		// 其他实例可能已轮换，以最新的key为准
+		_, e := sv.rotate(id, func(key *Key) error {
+			if !key.NeedRotation(now) {
+				return errRotationNotDue
+			}
+			return nil
+		})
+		if e == errRotationNotDue {
+			continue
+		}
+		if e != nil {
+			err = e
+			logger.Errorf("rotate key=%s err=%v", id, e)
+			continue
+		}
+		rotated = append(rotated, id)
+	}
+
+	return
+}
+
+// RunKeyScheduler rotates keys needing rotation and deletes keys whose deletion time has come
+// every interval until ctx is done
+func (sv *KeyService) RunKeyScheduler(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = rotationCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := sv.RotateDueKeys(); err == ErrStorageNotIterable {
+			logger.Errorf("key scheduler exit, err=%v", err)
+			return
+		}
+		if _, err := sv.DeleteDueKeys(); err != nil && err != ErrStorageNotDeletable {
+			logger.Errorf("delete due keys err=%v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
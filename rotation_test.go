@@ -0,0 +1,161 @@
+package keyservice
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKey(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	str := "hello,world!"
+	encrypted, err := sv.Encrypt(str, _testKeyId2)
+	require.NoError(t, err)
+
+	key, err := sv.RotateKey(_testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(3), key.Version)
+	assert.Equal(t, 3, len(key.Versions))
+	assert.Equal(t, KeyStateDecryptOnly, key.Get(2).State)
+	assert.Equal(t, KeyStateActive, key.Current().State)
+	assert.NotEmpty(t, key.Current().Value)
+	assert.NotEqual(t, key.Get(2).Value, key.Current().Value)
+
+	// origin key is not modified
+	assert.Equal(t, uint16(2), _testKey2.Version)
+	assert.Equal(t, KeyStateActive, _testKey2.Current().State)
+
+	// persisted
+	keys, err := s.LoadMany([]string{_testKeyId2})
+	require.NoError(t, err)
+	assert.EqualValues(t, key, keys[_testKeyId2])
+
+	// cache refreshed
+	assert.Equal(t, uint16(3), sv.GetKey(_testKeyId2).Version)
+
+	decrypted, err := sv.Decrypt(encrypted, _testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, str, decrypted)
+
+	encrypted, err = sv.Encrypt(str, _testKeyId2)
+	require.NoError(t, err)
+	decrypted, err = sv.Decrypt(encrypted, _testKeyId2)
+	require.NoError(t, err)
+	assert.Equal(t, str, decrypted)
+
+	_, err = sv.RotateKey("key-not-exists-id")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestRotateDueKeys(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	now := time.Now().Unix()
+	s.Store("key-due", &Key{
+		Version:        1,
+		Versions:       []*KeyVersion{{Version: 1, Value: "value-1", State: KeyStateActive, CreatedAt: now - 100}},
+		RotationPeriod: 60,
+	})
+	s.Store("key-not-due", &Key{
+		Version:        1,
+		Versions:       []*KeyVersion{{Version: 1, Value: "value-1", State: KeyStateActive, CreatedAt: now - 10}},
+		RotationPeriod: 60,
+	})
+
+	rotated, err := sv.RotateDueKeys()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key-due"}, rotated)
+
+	keys, err := s.LoadMany([]string{"key-due", "key-not-due"})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(2), keys["key-due"].Version)
+	assert.Equal(t, uint16(1), keys["key-not-due"].Version)
+
+	// newly rotated key is not due
+	rotated, err = sv.RotateDueKeys()
+	require.NoError(t, err)
+	assert.Empty(t, rotated)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	sv.RunKeyScheduler(ctx, time.Millisecond)
+}
+
+func TestRotateKeyConcurrently(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	secret := "secret-key"
+	require.NoError(t, writeFileKeyStoreContents(file, map[string]*Key{"key1": NewKey("key1-value")}, secret))
+	defer os.Remove(file)
+	defer os.Remove(file + ".lock")
+
+	// service instances sharing the keystore file
+	instances, rotations := 4, 5
+	services := make([]*KeyService, instances)
+	for i := range services {
+		s, err := NewFileStorage(file, secret)
+		require.NoError(t, err)
+		services[i] = NewKeyService("seed-key", s, NoCache)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		rotated = make(map[uint16]string)
+	)
+	for _, sv := range services {
+		for j := 0; j < rotations; j++ {
+			wg.Add(1)
+			go func(sv *KeyService) {
+				defer wg.Done()
+				key, err := sv.RotateKey("key1")
+				if !assert.NoError(t, err) {
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				_, exists := rotated[key.Version]
+				assert.False(t, exists, "version %d rotated twice", key.Version)
+				rotated[key.Version] = key.Current().Value
+			}(sv)
+		}
+	}
+	wg.Wait()
+
+	// no rotation is lost
+	key, err := services[0].loadKey("key1")
+	require.NoError(t, err)
+	total := instances*rotations + 1
+	assert.Equal(t, uint16(total), key.Version)
+	require.Equal(t, total, len(key.Versions))
+	for i, v := range key.Versions {
+		assert.Equal(t, uint16(i+1), v.Version)
+		if v.Version == key.Version {
+			assert.Equal(t, KeyStateActive, v.State)
+		} else {
+			assert.Equal(t, KeyStateDecryptOnly, v.State)
+		}
+		if v.Version > 1 {
+			assert.Equal(t, rotated[v.Version], v.Value)
+		}
+	}
+
+	// stale write is rejected
+	s, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+	stale := NewKey("stale-value")
+	assert.Equal(t, ErrConflict, s.CompareAndStore("key1", stale, stale))
+	assert.Equal(t, ErrConflict, s.CompareAndStore("key1", nil, stale))
+	require.NoError(t, s.CompareAndStore("key1", key, stale))
+	require.NoError(t, s.CompareAndStore("key2", nil, stale))
+}
@@ -5,7 +5,7 @@ import (
 	"crypto/md5"
 	"encoding/base64"
 	"errors"
-	"strings"
+	"sync"
 	"time"
 )
 
@@ -14,31 +14,22 @@ var (
 	ErrExpired              = errors.New("Expired")
 	ErrInvalidEncryptedData = errors.New("Invalid encrypted data")
 	ErrSignatureError       = errors.New("Signature error")
+	ErrKeyDisabled          = errors.New("Key disabled")
 )
 
 var keyExpireTime = 1 * time.Minute
 
-// Key contains data of key
-type Key struct {
-	Version          uint16 `json:"n,omitempty"` // 密钥版本
-	Value            string `json:"v,omitempty"` // 当前生效的密钥
-	ValueWillExpired string `json:"o,omitempty"` // 即将过期的密钥
-}
-
-func (k *Key) Copy() *Key {
-	return &Key{
-		Version: k.Version,
-		Value: k.Value,
-		ValueWillExpired: k.ValueWillExpired,
-	}
-}
-
 // KeyService provides cipher service
 type KeyService struct {
 	seedKey []byte
 	storage Storage
 	cache   *cacheWrapper
 
+	refresher *refresher
+	breaker   *storageBreaker
+	// 串行化进程内同一key的修改
+	keyLocks [keyLockCount]sync.Mutex
+
 	signatureSize int
 	versionSize   int
 }
@@ -50,6 +41,8 @@ func NewKeyService(seedKey string, storage Storage, cache Cache) *KeyService {
 		seedKey:       []byte(seedKey),
 		storage:       storage,
 		cache:         newCacheWrapper(cache, newCipher(seedKey), keyExpireTime),
+		refresher:     newRefresher(DefaultRefreshOptions),
+		breaker:       newStorageBreaker(DefaultResilienceOptions),
 		versionSize:   2,
 		signatureSize: 4,
 	}
@@ -57,87 +50,168 @@ func NewKeyService(seedKey string, storage Storage, cache Cache) *KeyService {
 
 // 根据Key ID批量获取远程key
 // map[keyID]key
+//
+// Expired keys are returned immediately and refreshed in background within RefreshOptions.MaxStaleness,
+// beyond that GetKeys waits for storage. If storage fails, cached keys loaded within
+// ResilienceOptions.MaxKeyAge are returned along with the error, see Health.
 func (sv *KeyService) GetKeys(ids []string) (keys map[string]*Key, err error) {
 	keys = make(map[string]*Key)
 
+	opts := sv.refresher.options()
+	now := time.Now()
 	needToRefreshIDs := make([]string, 0)
+	backgroundIDs := make([]string, 0)
+	// 需要刷新的缓存key，storage故障时使用
+	staleItems := make(map[string]*cacheItem)
 
 	for _, id := range ids {
-		if key, err := sv.cache.Load(id); err == nil || err == ErrExpired {
-			keys[id] = key
-			if err == ErrExpired {
+		item, err := sv.cache.LoadItem(id)
+		switch err {
+		case nil:
+			keys[id] = item.Value
+			if opts.RefreshAhead > 0 && now.Add(opts.RefreshAhead).After(item.Expiration) {
+				backgroundIDs = append(backgroundIDs, id)
+			}
+		case ErrExpired:
+			keys[id] = item.Value
+			if now.Sub(item.Expiration) < opts.MaxStaleness {
+				backgroundIDs = append(backgroundIDs, id)
+			} else {
 				needToRefreshIDs = append(needToRefreshIDs, id)
+				staleItems[id] = item
 			}
-		} else {
+		case errNotFoundCached:
+		default:
 			needToRefreshIDs = append(needToRefreshIDs, id)
 		}
 	}
 
+	if len(backgroundIDs) > 0 {
+		sv.refreshAsync(backgroundIDs)
+	}
+
 	if len(needToRefreshIDs) > 0 {
 		var rkeys map[string]*Key
-		logger.Debugf("load keys=%s from storage", strings.Join(needToRefreshIDs, ","))
-		rkeys, err = sv.storage.LoadMany(needToRefreshIDs)
+		rkeys, err = sv.loadKeys(needToRefreshIDs)
 		if err == nil {
-			for id, key := range rkeys {
-				keys[id] = key
-				sv.cache.Store(id, key)
+			for _, id := range needToRefreshIDs {
+				if key, ok := rkeys[id]; ok {
+					keys[id] = key
+				} else {
+					delete(keys, id)
+				}
 			}
-		} else {
-			logger.Errorf("load keys=%s from storage err=%v", strings.Join(needToRefreshIDs, ","), err)
+		} else if len(staleItems) > 0 {
+			served := 0
+			for id, item := range staleItems {
+				if sv.usableWhenStorageFails(item, now) {
+					served++
+				} else {
+					delete(keys, id)
+				}
+			}
+			sv.breaker.staleServed(served)
 		}
 	}
 
 	return
 }
 
+// SetMemoryCache replaces in-process memory cache of keys with the options,
+// it should be called before KeyService is used.
+func (sv *KeyService) SetMemoryCache(opts MemoryCacheOptions) {
+	sv.cache.setMemoryCache(opts)
+}
+
+// CacheStats returns statistics of in-process memory cache of keys
+func (sv *KeyService) CacheStats() CacheStats {
+	return sv.cache.buffer().Stats()
+}
+
+// GetKey returns key specified by id, or nil if key doesn't exist or can't be loaded, see LookupKey
 func (sv *KeyService) GetKey(id string) (key *Key) {
-	keys, _ := sv.GetKeys([]string{id})
+	key, _ = sv.LookupKey(id)
+	return
+}
 
-	if len(keys) > 0 {
-		return keys[id]
+// LookupKey returns key specified by id. ErrNotFound is returned if key doesn't exist,
+// ErrStorageUnavailable if key can't be loaded because storage fails and no usable cached copy exists.
+func (sv *KeyService) LookupKey(id string) (*Key, error) {
+	keys, err := sv.GetKeys([]string{id})
+	if key := keys[id]; key != nil {
+		return key, nil
 	}
-
-	return nil
+	if err != nil {
+		// 具体错误已记录在日志和Health中
+		return nil, ErrStorageUnavailable
+	}
+	return nil, ErrNotFound
 }
 
 // Encrypt encrypt content with key specified by keyID
 func (sv *KeyService) Encrypt(content string, keyID string) (ret string, err error) {
-	key := sv.GetKey(keyID)
+	return sv.EncryptWithContext(content, keyID, nil)
+}
 
-	if key == nil {
-		err = ErrNotFound
+// EncryptWithContext encrypt content with key specified by keyID,
+// and binds context(e.g. tenant id, table/column name) to the encrypted data.
+// The same context must be provided to DecryptWithContext.
+func (sv *KeyService) EncryptWithContext(content string, keyID string, context map[string]string) (ret string, err error) {
+	bs, err := sv.encrypt([]byte(content), keyID, context, false)
+	if err != nil {
 		return
 	}
 
-	cipher := newCipher(key.Value)
-	cipherBytes, err := cipher.Encrypt([]byte(content))
+	ret = base64.RawURLEncoding.EncodeToString(bs)
+
+	return
+}
 
+// EncryptDeterministic encrypt content in deterministic mode whatever the mode of key is,
+// the same content, key version and context always yields the same encrypted data,
+// so it can be used for equality lookups. Data encrypted after key rotation differs.
+func (sv *KeyService) EncryptDeterministic(content string, keyID string, context map[string]string) (ret string, err error) {
+	bs, err := sv.encrypt([]byte(content), keyID, context, true)
 	if err != nil {
 		return
 	}
 
-	// cipher data byte size
-	cl := len(cipherBytes)
-	// seed key byte size
-	kl := len(sv.seedKey)
-	// signature byte size
-	sl := sv.signatureSize
-	// version bytes size
-	vl := sv.versionSize
-	// sig(4 bytes)+verion(2 bytes)+cipherBytes+seedKey
-	bs := make([]byte, sl+vl+cl+kl)
-	copy(bs[sl+vl:], cipherBytes)
-	copy(bs[sl+vl+cl:], sv.seedKey)
-	bs[sl] = byte(key.Version >> 8)
-	bs[sl+1] = byte(key.Version & 0xff)
-	sig := md5.Sum(bs[sl:])
-	copy(bs[0:sl], shortSignature(sig, int(sl)))
-
-	ret = base64.RawURLEncoding.EncodeToString(bs[0 : sl+vl+cl])
+	ret = base64.RawURLEncoding.EncodeToString(bs)
 
 	return
 }
 
+// EncryptBytesDeterministic is like EncryptDeterministic, returns raw envelope bytes instead of base64 string
+func (sv *KeyService) EncryptBytesDeterministic(content []byte, keyID string, context map[string]string) ([]byte, error) {
+	return sv.encrypt(content, keyID, context, true)
+}
+
+// EncryptBytes encrypt content with key specified by keyID, returns raw envelope bytes instead of base64 string
+func (sv *KeyService) EncryptBytes(content []byte, keyID string) ([]byte, error) {
+	return sv.encrypt(content, keyID, nil, false)
+}
+
+// EncryptBytesWithContext is like EncryptWithContext, returns raw envelope bytes instead of base64 string
+func (sv *KeyService) EncryptBytesWithContext(content []byte, keyID string, context map[string]string) ([]byte, error) {
+	return sv.encrypt(content, keyID, context, false)
+}
+
+// encrypt encrypts content to envelope bytes with current version of key,
+// deterministic mode is used if it's required or it's the mode of key.
+func (sv *KeyService) encrypt(content []byte, keyID string, context map[string]string, deterministic bool) ([]byte, error) {
+	key, err := sv.LookupKey(keyID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.IsActive() {
+		return nil, ErrKeyDisabled
+	}
+
+	return sv.seal(content, key, keyID, context, deterministic || key.Mode == EncryptionModeDeterministic)
+}
+
 func shortSignature(whole [16]byte, size int) []byte {
 	if size > len(whole) {
 		return whole[0:]
@@ -146,56 +220,145 @@ func shortSignature(whole [16]byte, size int) []byte {
 	return whole[start : start+size]
 }
 
+// Decrypt decrypt content encrypted by Encrypt, data encrypted in legacy format is also accepted
 func (sv *KeyService) Decrypt(content string, keyID string) (ret string, err error) {
-	key := sv.GetKey(keyID)
+	return sv.DecryptWithContext(content, keyID, nil)
+}
 
-	if key == nil {
-		err = ErrNotFound
+// DecryptWithContext decrypt content encrypted by EncryptWithContext with the same context.
+// Legacy format data has no context bound, it's accepted only if context is empty.
+func (sv *KeyService) DecryptWithContext(content string, keyID string, context map[string]string) (ret string, err error) {
+	cipherData, err := base64.RawURLEncoding.DecodeString(content)
+	if err != nil {
+		// 密钥不存在或已禁用时优先返回对应错误
+		if key, e := sv.LookupKey(keyID); e != nil {
+			err = e
+		} else if !key.Enabled() {
+			err = ErrKeyDisabled
+		} else {
+			err = ErrInvalidEncryptedData
+		}
 		return
 	}
 
-	cipherData, err := base64.RawURLEncoding.DecodeString(content)
+	s, err := sv.decrypt(cipherData, keyID, context)
 	if err != nil {
 		return
 	}
 
+	ret = string(s)
+
+	return
+}
+
+// DecryptBytes decrypt raw envelope bytes returned by EncryptBytes
+func (sv *KeyService) DecryptBytes(content []byte, keyID string) ([]byte, error) {
+	return sv.decrypt(content, keyID, nil)
+}
+
+// DecryptBytesWithContext decrypt raw envelope bytes returned by EncryptBytesWithContext with the same context
+func (sv *KeyService) DecryptBytesWithContext(content []byte, keyID string, context map[string]string) ([]byte, error) {
+	return sv.decrypt(content, keyID, context)
+}
+
+// decrypt decrypts envelope bytes generated by encrypt, or legacy format data
+func (sv *KeyService) decrypt(cipherData []byte, keyID string, context map[string]string) (ret []byte, err error) {
+	key, err := sv.LookupKey(keyID)
+
+	if err != nil {
+		return
+	}
+
+	if !key.Enabled() {
+		err = ErrKeyDisabled
+		return
+	}
+
+	var openErr error
+	if len(cipherData) > 0 && (cipherData[0] == formatAESGCM || cipherData[0] == formatDeterministic) {
+		if ret, openErr = sv.open(cipherData, key, keyID, context); openErr == nil {
+			return
+		}
+		if len(context) > 0 {
+			err = openErr
+			return
+		}
+		// 旧格式的签名首字节也可能与格式标识相同，继续尝试旧格式
+	}
+
+	if len(context) > 0 {
+		err = ErrInvalidEncryptedData
+		return
+	}
+
+	ret, err = sv.decryptLegacy(cipherData, key)
+	if err != nil && openErr != nil {
+		err = openErr
+	}
+
+	return
+}
+
+// decryptLegacy decrypt data in legacy format: sig(4 bytes)+version(2 bytes)+AES-128-CBC data
+func (sv *KeyService) decryptLegacy(cipherData []byte, key *Key) (ret []byte, err error) {
 	sl := sv.signatureSize
 	vl := sv.versionSize
-	kl := len(sv.seedKey)
-	cl := len(cipherData)
-	if cl < sl+vl+1 {
+	if len(cipherData) < sl+vl+1 {
 		err = ErrInvalidEncryptedData
 		return
 	}
 
-	bs := make([]byte, cl+kl)
-	copy(bs, cipherData)
-	copy(bs[cl:], sv.seedKey)
-	sig := md5.Sum(bs[sl:])
-
-	if !bytes.Equal(bs[0:sl], shortSignature(sig, sl)) {
+	if !sv.legacySigned(cipherData) {
 		err = ErrSignatureError
 		return
 	}
 
-	version := (uint16(bs[sl]) << 8) | uint16(bs[sl+1])
-	keyValue := key.Value
-	if version != key.Version {
-		// is previous version key
-		if version == key.Version-1 && key.ValueWillExpired != "" {
-			keyValue = key.ValueWillExpired
-		}
+	version := (uint16(cipherData[sl]) << 8) | uint16(cipherData[sl+1])
+	keyValue := key.valueOf(version)
+	if keyValue == "" {
+		err = ErrInvalidEncryptedData
+		return
 	}
 
 	cipher := newCipher(keyValue)
 
-	s, err := cipher.Decrypt(cipherData[sl+vl:])
+	ret, err = cipher.Decrypt(cipherData[sl+vl:])
 
 	if err != nil {
+		err = ErrInvalidEncryptedData
 		return
 	}
 
-	ret = string(s)
-
 	return
 }
+
+// legacySigned reports whether data has valid signature of legacy format
+func (sv *KeyService) legacySigned(cipherData []byte) bool {
+	sl := sv.signatureSize
+	cl := len(cipherData)
+	if cl < sl {
+		return false
+	}
+
+	bs := make([]byte, cl+len(sv.seedKey))
+	copy(bs, cipherData)
+	copy(bs[cl:], sv.seedKey)
+	sig := md5.Sum(bs[sl:])
+
+	return bytes.Equal(bs[0:sl], shortSignature(sig, sl))
+}
+
+// KeyVersionOf returns version of key that encrypted data(envelope, stream header or legacy format),
+// or 0 if data is invalid. It doesn't decrypt data, so the version is not authenticated.
+func (sv *KeyService) KeyVersionOf(cipherData []byte) uint16 {
+	if len(cipherData) >= sv.signatureSize+sv.versionSize+1 && sv.legacySigned(cipherData) {
+		return (uint16(cipherData[sv.signatureSize]) << 8) | uint16(cipherData[sv.signatureSize+1])
+	}
+
+	format, version, ok := parseEnvelopeHeader(cipherData)
+	if ok && (format == formatAESGCM || format == formatStream || format == formatDeterministic) {
+		return version
+	}
+
+	return 0
+}
@@ -0,0 +1,11 @@
+package main
+
+// database/sql drivers available to SQL storage, selected by storage.driver:
+// postgres, mysql or sqlite3.
+//
+// sqlite3 driver requires cgo, it fails at runtime in binaries built with CGO_ENABLED=0.
+import (
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/techxmind/keyservice"
+	pb "github.com/techxmind/keyservice/interface-defs"
+)
+
+// request id header(gRPC metadata), generated if not set
+const requestIDHeader = "X-Request-Id"
+
+// Audit settings, must be set before serving
+var (
+	// nil to disable audit log
+	auditor *keyservice.Auditor
+	// resolves key versions of encrypted data
+	auditKeys *keyservice.KeyService
+)
+
+// SetAuditor enables audit log, ks is used to resolve key versions of encrypted data in requests
+func SetAuditor(a *keyservice.Auditor, ks *keyservice.KeyService) {
+	auditor = a
+	auditKeys = ks
+}
+
+// HTTPRequestIDToContext is httptransport.ServerBefore function that puts request id to context
+func HTTPRequestIDToContext(ctx context.Context, r *http.Request) context.Context {
+	return requestIDToContext(ctx, r.Header.Get(requestIDHeader))
+}
+
+// GRPCRequestIDToContext is grpctransport.ServerBefore function that puts request id to context
+func GRPCRequestIDToContext(ctx context.Context, md metadata.MD) context.Context {
+	requestID := ""
+	if v := md.Get(requestIDHeader); len(v) > 0 {
+		requestID = v[0]
+	}
+	return requestIDToContext(ctx, requestID)
+}
+
+func requestIDToContext(ctx context.Context, requestID string) context.Context {
+	if requestID == "" {
+		bs := make([]byte, 16)
+		rand.Read(bs)
+		requestID = hex.EncodeToString(bs)
+	} else if len(requestID) > 128 {
+		requestID = requestID[:128]
+	}
+	return keyservice.ContextWithRequestID(ctx, requestID)
+}
+
+// Audit is LabeledMiddleware that writes one audit event per key of request, see SetAuditor
+func Audit(label string, in endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		resp, err := in(ctx, req)
+		if auditor == nil {
+			return resp, err
+		}
+
+		var events []*keyservice.AuditEvent
+		if err != nil {
+			code, msg := errorCode(err)
+			_, keyIDs, _ := authorizationScope(req)
+			for _, id := range keyIDs {
+				events = append(events, &keyservice.AuditEvent{KeyID: id, Code: code, Msg: msg})
+			}
+		} else {
+			events = auditEvents(req, resp)
+		}
+
+		caller := keyservice.CallerFromContext(ctx)
+		requestID := keyservice.RequestIDFromContext(ctx)
+		for _, e := range events {
+			e.RequestID = requestID
+			e.Caller = caller
+			e.Operation = label
+			auditor.Audit(e)
+		}
+
+		return resp, err
+	}
+}
+
+// auditEvents returns events of request with key id, key version and result code filled
+func auditEvents(req interface{}, resp interface{}) []*keyservice.AuditEvent {
+	switch r := req.(type) {
+	case *pb.EncryptRequest:
+		return []*keyservice.AuditEvent{encryptEvent(r, resp.(*pb.Response))}
+	case *pb.EncryptBatchRequest:
+		return batchEvents(len(r.Items), resp.(*pb.BatchResponse), func(i int, resp *pb.Response) *keyservice.AuditEvent {
+			return encryptEvent(r.Items[i], resp)
+		})
+	case *pb.DecryptRequest:
+		return []*keyservice.AuditEvent{decryptEvent(r, resp.(*pb.Response))}
+	case *pb.DecryptBatchRequest:
+		return batchEvents(len(r.Items), resp.(*pb.BatchResponse), func(i int, resp *pb.Response) *keyservice.AuditEvent {
+			return decryptEvent(r.Items[i], resp)
+		})
+	case *pb.KeyRequest:
+		resp := resp.(*pb.KeyResponse)
+		events := make([]*keyservice.AuditEvent, 0, len(r.KeyIds))
+		for _, id := range r.KeyIds {
+			e := &keyservice.AuditEvent{KeyID: id, Code: resp.Code, Msg: resp.Msg}
+			if data, ok := resp.Result[id]; ok {
+				var key keyservice.Key
+				if json.Unmarshal([]byte(data), &key) == nil {
+					e.KeyVersion = key.Version
+				}
+			} else if resp.Code == CodeOK {
+				e.Code, e.Msg = errorCode(keyservice.ErrNotFound)
+			}
+			events = append(events, e)
+		}
+		return events
+	case *pb.RotateRequest:
+		return []*keyservice.AuditEvent{{KeyID: r.KeyId, Code: resp.(*pb.Response).Code, Msg: resp.(*pb.Response).Msg}}
+	case *pb.CreateKeyRequest:
+		return []*keyservice.AuditEvent{keyMetaEvent(r.KeyId, resp.(*pb.KeyMetaResponse))}
+	case *pb.KeyIdRequest:
+		return []*keyservice.AuditEvent{keyMetaEvent(r.KeyId, resp.(*pb.KeyMetaResponse))}
+	case *pb.ScheduleKeyDeletionRequest:
+		return []*keyservice.AuditEvent{keyMetaEvent(r.KeyId, resp.(*pb.KeyMetaResponse))}
+	case *pb.ListKeysRequest:
+		resp := resp.(*pb.ListKeysResponse)
+		return []*keyservice.AuditEvent{{Code: resp.Code, Msg: resp.Msg}}
+	case *pb.GenerateDataKeyRequest:
+		resp := resp.(*pb.DataKeyResponse)
+		return []*keyservice.AuditEvent{{KeyID: r.KeyId, KeyVersion: encryptedVersion(resp.Wrapped), Code: resp.Code, Msg: resp.Msg}}
+	case *pb.UnwrapDataKeyRequest:
+		resp := resp.(*pb.DataKeyResponse)
+		return []*keyservice.AuditEvent{{KeyID: r.KeyId, KeyVersion: encryptedVersion(r.Wrapped), Code: resp.Code, Msg: resp.Msg}}
+	case *pb.BlindIndexRequest:
+		resp := resp.(*pb.BlindIndexResponse)
+		return []*keyservice.AuditEvent{{KeyID: r.KeyId, Code: resp.Code, Msg: resp.Msg}}
+	case *pb.BlindIndexBatchRequest:
+		resp := resp.(*pb.BlindIndexBatchResponse)
+		events := make([]*keyservice.AuditEvent, 0, len(r.Items))
+		for i, item := range r.Items {
+			e := &keyservice.AuditEvent{KeyID: item.KeyId, Code: resp.Code, Msg: resp.Msg}
+			if i < len(resp.Results) {
+				e.Code, e.Msg = resp.Results[i].Code, resp.Results[i].Msg
+			}
+			events = append(events, e)
+		}
+		return events
+	case *pb.TokenizeRequest:
+		resp := resp.(*pb.TokenizeResponse)
+		return []*keyservice.AuditEvent{{KeyID: r.KeyId, KeyVersion: uint16(resp.Version), Code: resp.Code, Msg: resp.Msg}}
+	case *pb.DetokenizeRequest:
+		resp := resp.(*pb.Response)
+		return []*keyservice.AuditEvent{{KeyID: r.KeyId, KeyVersion: uint16(r.Version), Code: resp.Code, Msg: resp.Msg}}
+	}
+	return nil
+}
+
+// batchEvents returns event of each item, batch error is used if item has no result
+func batchEvents(n int, resp *pb.BatchResponse, event func(i int, resp *pb.Response) *keyservice.AuditEvent) []*keyservice.AuditEvent {
+	events := make([]*keyservice.AuditEvent, 0, n)
+	for i := 0; i < n; i++ {
+		item := &pb.Response{Code: resp.Code, Msg: resp.Msg}
+		if i < len(resp.Results) {
+			item = resp.Results[i]
+		}
+		events = append(events, event(i, item))
+	}
+	return events
+}
+
+func encryptEvent(req *pb.EncryptRequest, resp *pb.Response) *keyservice.AuditEvent {
+	e := &keyservice.AuditEvent{KeyID: req.KeyId, Code: resp.Code, Msg: resp.Msg}
+	if len(resp.RawResult) > 0 {
+		e.KeyVersion = auditKeys.KeyVersionOf(resp.RawResult)
+	} else {
+		e.KeyVersion = encryptedVersion(resp.Result)
+	}
+	return e
+}
+
+func decryptEvent(req *pb.DecryptRequest, resp *pb.Response) *keyservice.AuditEvent {
+	e := &keyservice.AuditEvent{KeyID: req.KeyId, Code: resp.Code, Msg: resp.Msg}
+	if len(req.RawCipher) > 0 {
+		e.KeyVersion = auditKeys.KeyVersionOf(req.RawCipher)
+	} else {
+		e.KeyVersion = encryptedVersion(req.Cipher)
+	}
+	return e
+}
+
+func keyMetaEvent(keyID string, resp *pb.KeyMetaResponse) *keyservice.AuditEvent {
+	e := &keyservice.AuditEvent{KeyID: keyID, Code: resp.Code, Msg: resp.Msg}
+	if resp.Result != nil {
+		e.KeyVersion = uint16(resp.Result.Version)
+	}
+	return e
+}
+
+// encryptedVersion returns key version of base64 encoded data, 0 if it's invalid
+func encryptedVersion(data string) uint16 {
+	bs, err := base64.RawURLEncoding.DecodeString(data)
+	if err != nil {
+		return 0
+	}
+	return auditKeys.KeyVersionOf(bs)
+}
+
+// auditStream records result code and header of data stream for audit
+type auditStream struct {
+	streamServer
+	ctx       context.Context
+	operation string
+	keyID     string
+	// 加密流取输出数据的头部，解密流取输入数据的头部
+	encrypt bool
+	head    []byte
+	code    int32
+	msg     string
+}
+
+func newAuditStream(ctx context.Context, stream streamServer, operation string, keyID string, encrypt bool) *auditStream {
+	return &auditStream{
+		streamServer: stream,
+		ctx:          ctx,
+		operation:    operation,
+		keyID:        keyID,
+		encrypt:      encrypt,
+	}
+}
+
+func (s *auditStream) Send(resp *pb.StreamResponse) error {
+	if resp.Code != CodeOK {
+		s.code, s.msg = resp.Code, resp.Msg
+	} else if s.encrypt {
+		s.recordHead(resp.Data)
+	}
+	return s.streamServer.Send(resp)
+}
+
+func (s *auditStream) Recv() (*pb.StreamRequest, error) {
+	in, err := s.streamServer.Recv()
+	if err == nil && !s.encrypt {
+		s.recordHead(in.Data)
+	}
+	return in, err
+}
+
+func (s *auditStream) recordHead(data []byte) {
+	// 只需要格式和版本
+	if n := 3 - len(s.head); n > 0 {
+		if n > len(data) {
+			n = len(data)
+		}
+		s.head = append(s.head, data[:n]...)
+	}
+}
+
+// audit writes event of stream, err is the error returned to gRPC
+func (s *auditStream) audit(err error) {
+	if auditor == nil {
+		return
+	}
+	e := &keyservice.AuditEvent{
+		RequestID: keyservice.RequestIDFromContext(s.ctx),
+		Caller:    keyservice.CallerFromContext(s.ctx),
+		Operation: s.operation,
+		KeyID:     s.keyID,
+		Code:      s.code,
+		Msg:       s.msg,
+	}
+	if e.Code == CodeOK && err != nil {
+		e.Code, e.Msg = errorCode(err)
+	}
+	e.KeyVersion = auditKeys.KeyVersionOf(s.head)
+	auditor.Audit(e)
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/techxmind/keyservice"
+	pb "github.com/techxmind/keyservice/interface-defs"
+)
+
+// memoryAuditSink keeps events in memory
+type memoryAuditSink struct {
+	events []*keyservice.AuditEvent
+}
+
+func (s *memoryAuditSink) WriteEvent(e *keyservice.AuditEvent) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *memoryAuditSink) Close() error {
+	return nil
+}
+
+// mapStorage keeps keys in memory
+type mapStorage map[string]*keyservice.Key
+
+func (s mapStorage) Store(id string, key *keyservice.Key) error {
+	s[id] = key
+	return nil
+}
+
+func (s mapStorage) LoadMany(ids []string) (map[string]*keyservice.Key, error) {
+	ret := make(map[string]*keyservice.Key)
+	for _, id := range ids {
+		if s[id] != nil {
+			ret[id] = s[id]
+		}
+	}
+	return ret, nil
+}
+
+func TestAudit(t *testing.T) {
+	sink := &memoryAuditSink{}
+	SetAuditor(keyservice.NewAuditor(sink), keyservice.NewKeyService("seed-key", mapStorage{}, keyservice.NoCache))
+	defer SetAuditor(nil, nil)
+
+	ctx := requestIDToContext(keyservice.ContextWithCaller(context.Background(), "cert:app"), "req-1")
+	respond := func(resp interface{}, err error) func(context.Context, interface{}) (interface{}, error) {
+		return func(context.Context, interface{}) (interface{}, error) {
+			return resp, err
+		}
+	}
+
+	_, err := Audit("EncryptBatch", respond(&pb.BatchResponse{Results: []*pb.Response{{}, {Code: CodeKeyNotFound, Msg: "Not found"}}}, nil))(
+		ctx, &pb.EncryptBatchRequest{Items: []*pb.EncryptRequest{{KeyId: "key1"}, {KeyId: "key2"}}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(sink.events))
+	for _, e := range sink.events {
+		assert.Equal(t, "req-1", e.RequestID)
+		assert.Equal(t, "cert:app", e.Caller)
+		assert.Equal(t, "EncryptBatch", e.Operation)
+		assert.False(t, e.Time.IsZero())
+	}
+	assert.Equal(t, "key1", sink.events[0].KeyID)
+	assert.Equal(t, CodeOK, sink.events[0].Code)
+	assert.Equal(t, "key2", sink.events[1].KeyID)
+	assert.Equal(t, CodeKeyNotFound, sink.events[1].Code)
+
+	// endpoint error
+	sink.events = nil
+	_, err = Audit("Rotate", respond(nil, errors.New("storage err")))(ctx, &pb.RotateRequest{KeyId: "key1"})
+	assert.NotNil(t, err)
+	require.Equal(t, 1, len(sink.events))
+	assert.Equal(t, CodeInternalError, sink.events[0].Code)
+
+	// denied requests are audited
+	SetPolicies(keyservice.Policies{})
+	defer SetPolicies(nil)
+	for _, c := range requestCases {
+		sink.events = nil
+		next := &okEndpoint{}
+		_, err = Audit(c.name, Authorize(next.endpoint))(ctx, c.req("key1"))
+		require.NoError(t, err)
+		require.NotEmpty(t, sink.events, c.name)
+		for _, e := range sink.events {
+			assert.Equal(t, CodePermissionDenied, e.Code, c.name)
+			assert.Equal(t, c.name, e.Operation)
+		}
+	}
+
+	// unknown request
+	sink.events = nil
+	next := &okEndpoint{}
+	_, err = Audit("Unknown", Authorize(next.endpoint))(ctx, &pb.Empty{})
+	assert.Equal(t, ErrPermissionDenied, err)
+	assert.Empty(t, sink.events)
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/techxmind/keyservice"
+	"github.com/techxmind/logger"
+)
+
+type authErrorContextKey struct{}
+
+// Authentication settings, must be set before serving
+var (
+	// nil to disable token authentication
+	tokenAuthenticator *keyservice.TokenAuthenticator
+	// header(gRPC metadata) name of caller identity, only for trusted networks, empty to disable
+	callerHeader string
+)
+
+// SetTokenAuthenticator enables API token authentication,
+// requests without client certificate or valid token are rejected.
+func SetTokenAuthenticator(a *keyservice.TokenAuthenticator) {
+	tokenAuthenticator = a
+}
+
+// SetCallerHeader trusts caller identity in the header(gRPC metadata),
+// only use it when callers are authenticated by a trusted proxy.
+func SetCallerHeader(name string) {
+	callerHeader = name
+}
+
+// HTTPCallerToContext is httptransport.ServerBefore function that puts caller identity to context
+func HTTPCallerToContext(ctx context.Context, r *http.Request) context.Context {
+	var certs []*x509.Certificate
+	if r.TLS != nil {
+		certs = r.TLS.PeerCertificates
+	}
+	header := ""
+	if callerHeader != "" {
+		header = r.Header.Get(callerHeader)
+	}
+	return callerToContext(ctx, certs, r.Header.Get("Authorization"), header)
+}
+
+// GRPCCallerToContext is grpctransport.ServerBefore function that puts caller identity to context
+func GRPCCallerToContext(ctx context.Context, md metadata.MD) context.Context {
+	var certs []*x509.Certificate
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			certs = info.State.PeerCertificates
+		}
+	}
+	first := func(name string) string {
+		if v := md.Get(name); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	header := ""
+	if callerHeader != "" {
+		header = first(callerHeader)
+	}
+	return callerToContext(ctx, certs, first("authorization"), header)
+}
+
+// callerToContext puts caller identity to context, client certificate(verified by TLS) takes precedence,
+// then API token and trusted header. Token error is put to context and rejected by Authenticate.
+func callerToContext(ctx context.Context, certs []*x509.Certificate, authorization string, header string) context.Context {
+	if len(certs) > 0 {
+		return keyservice.ContextWithCaller(ctx, "cert:"+certs[0].Subject.CommonName)
+	}
+
+	if tokenAuthenticator != nil && authorization != "" {
+		token := authorization
+		if len(token) > 7 && strings.EqualFold(token[:7], "Bearer ") {
+			token = token[7:]
+		}
+		name, err := tokenAuthenticator.Authenticate(token)
+		if err != nil {
+			return context.WithValue(ctx, authErrorContextKey{}, err)
+		}
+		return keyservice.ContextWithCaller(ctx, "token:"+name)
+	}
+
+	if header != "" {
+		return keyservice.ContextWithCaller(ctx, "header:"+header)
+	}
+
+	return ctx
+}
+
+// authenticated returns error if caller in context is not authenticated
+func authenticated(ctx context.Context) error {
+	if tokenAuthenticator == nil {
+		return nil
+	}
+	if err, ok := ctx.Value(authErrorContextKey{}).(error); ok {
+		logger.Infof("authenticate err=%v", err)
+		return err
+	}
+	if keyservice.CallerFromContext(ctx) == "" {
+		return ErrUnauthenticated
+	}
+	return nil
+}
+
+// Authenticate is endpoint middleware that rejects requests of unauthenticated callers
+// with CodeUnauthenticated if token authentication is enabled, unknown requests are always rejected.
+func Authenticate(in endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, _, deny := authorizationScope(req)
+		if deny == nil {
+			logger.Errorf("unauthenticated unknown request=%T", req)
+			return nil, ErrUnauthenticated
+		}
+		if err := authenticated(ctx); err != nil {
+			return deny(errorCode(err)), nil
+		}
+		return in(ctx, req)
+	}
+}
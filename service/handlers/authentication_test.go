@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/techxmind/keyservice"
+	pb "github.com/techxmind/keyservice/interface-defs"
+)
+
+func TestCallerToContext(t *testing.T) {
+	a, err := keyservice.NewTokenAuthenticator("", "token-secret")
+	require.NoError(t, err)
+	SetTokenAuthenticator(a)
+	SetCallerHeader("X-Caller")
+	defer SetTokenAuthenticator(nil)
+	defer SetCallerHeader("")
+
+	token, err := keyservice.SignToken("token-secret", "report-job", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	certs := []*x509.Certificate{{Subject: pkix.Name{CommonName: "order-service"}}}
+
+	// client certificate takes precedence
+	ctx := callerToContext(context.Background(), certs, "Bearer "+token, "header-caller")
+	assert.Equal(t, "cert:order-service", keyservice.CallerFromContext(ctx))
+
+	ctx = callerToContext(context.Background(), nil, "Bearer "+token, "header-caller")
+	assert.Equal(t, "token:report-job", keyservice.CallerFromContext(ctx))
+	ctx = callerToContext(context.Background(), nil, token, "")
+	assert.Equal(t, "token:report-job", keyservice.CallerFromContext(ctx))
+
+	ctx = callerToContext(context.Background(), nil, "", "header-caller")
+	assert.Equal(t, "header:header-caller", keyservice.CallerFromContext(ctx))
+
+	// invalid token isn't downgraded to header caller
+	ctx = callerToContext(context.Background(), nil, "Bearer invalid", "header-caller")
+	assert.Empty(t, keyservice.CallerFromContext(ctx))
+	assert.Equal(t, keyservice.ErrInvalidToken, authenticated(ctx))
+}
+
+func TestAuthenticate(t *testing.T) {
+	// authentication disabled
+	for _, c := range requestCases {
+		next := &okEndpoint{}
+		_, err := Authenticate(next.endpoint)(context.Background(), c.req("app.key"))
+		require.NoError(t, err)
+		assert.True(t, next.called, c.name)
+	}
+	_, err := Authenticate((&okEndpoint{}).endpoint)(context.Background(), &pb.Empty{})
+	assert.Equal(t, ErrUnauthenticated, err)
+
+	a, err := keyservice.NewTokenAuthenticator("", "token-secret")
+	require.NoError(t, err)
+	SetTokenAuthenticator(a)
+	defer SetTokenAuthenticator(nil)
+
+	token, err := keyservice.SignToken("token-secret", "report-job", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	expired, err := keyservice.SignToken("token-secret", "report-job", time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	forged, err := keyservice.SignToken("other-secret", "report-job", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	certs := []*x509.Certificate{{Subject: pkix.Name{CommonName: "order-service"}}}
+
+	for _, c := range requestCases {
+		t.Run(c.name, func(t *testing.T) {
+			allowed := map[string]context.Context{
+				"token":       callerToContext(context.Background(), nil, "Bearer "+token, ""),
+				"certificate": callerToContext(context.Background(), certs, "", ""),
+			}
+			for name, ctx := range allowed {
+				next := &okEndpoint{}
+				_, err := Authenticate(next.endpoint)(ctx, c.req("app.key"))
+				require.NoError(t, err, name)
+				assert.True(t, next.called, name)
+			}
+
+			rejected := map[string]context.Context{
+				"no token":      context.Background(),
+				"invalid token": callerToContext(context.Background(), nil, "Bearer invalid", ""),
+				"expired token": callerToContext(context.Background(), nil, "Bearer "+expired, ""),
+				"forged token":  callerToContext(context.Background(), nil, "Bearer "+forged, ""),
+			}
+			for name, ctx := range rejected {
+				next := &okEndpoint{}
+				resp, err := Authenticate(next.endpoint)(ctx, c.req("app.key"))
+				require.NoError(t, err, name)
+				assert.False(t, next.called, name)
+				assert.IsType(t, c.resp, resp, name)
+				assert.Equal(t, CodeUnauthenticated, resp.(codeResponse).GetCode(), name)
+			}
+		})
+	}
+
+	// unknown request is rejected
+	next := &okEndpoint{}
+	_, err = Authenticate(next.endpoint)(callerToContext(context.Background(), nil, "Bearer "+token, ""), &pb.Empty{})
+	assert.Equal(t, ErrUnauthenticated, err)
+	assert.False(t, next.called)
+}
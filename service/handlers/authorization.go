@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/techxmind/keyservice"
+	pb "github.com/techxmind/keyservice/interface-defs"
+	"github.com/techxmind/logger"
+)
+
+// nil to disable authorization, must be set before serving
+var policies keyservice.Policies
+
+// SetPolicies enables authorization with policies
+func SetPolicies(ps keyservice.Policies) {
+	policies = ps
+}
+
+// authorized reports whether caller in context can do op with all the keys
+func authorized(ctx context.Context, op keyservice.Operation, keyIDs ...string) bool {
+	if policies == nil {
+		return true
+	}
+	caller := keyservice.CallerFromContext(ctx)
+	for _, id := range keyIDs {
+		if !policies.Allowed(caller, id, op) {
+			logger.Infof("permission denied caller=%s key=%s op=%s", caller, id, op)
+			return false
+		}
+	}
+	return true
+}
+
+// Authorize is endpoint middleware that checks policies of caller,
+// denied requests get response with CodePermissionDenied, unknown requests are always denied.
+func Authorize(in endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		op, keyIDs, deny := authorizationScope(req)
+		if deny == nil {
+			// 未知请求默认拒绝，新增接口必须在authorizationScope中声明
+			logger.Errorf("permission denied unknown request=%T", req)
+			return nil, ErrPermissionDenied
+		}
+		if !authorized(ctx, op, keyIDs...) {
+			return deny(errorCode(ErrPermissionDenied)), nil
+		}
+		return in(ctx, req)
+	}
+}
+
+// authorizationScope returns operation and key ids of request, and function to make denied response.
+// deny is nil if request is unknown, such request is denied by Authorize and Authenticate.
+func authorizationScope(req interface{}) (op keyservice.Operation, keyIDs []string, deny func(code int32, msg string) interface{}) {
+	switch r := req.(type) {
+	case *pb.EncryptRequest:
+		return keyservice.OperationEncrypt, []string{r.KeyId}, denyResponse
+	case *pb.EncryptBatchRequest:
+		for _, item := range r.Items {
+			keyIDs = append(keyIDs, item.KeyId)
+		}
+		return keyservice.OperationEncrypt, keyIDs, denyBatchResponse
+	case *pb.DecryptRequest:
+		return decryptOperation(r), []string{r.KeyId}, denyResponse
+	case *pb.DecryptBatchRequest:
+		// 所有条目都掩码时才按decrypt:masked授权
+		op = keyservice.OperationDecryptMasked
+		for _, item := range r.Items {
+			keyIDs = append(keyIDs, item.KeyId)
+			if decryptOperation(item) == keyservice.OperationDecrypt {
+				op = keyservice.OperationDecrypt
+			}
+		}
+		return op, keyIDs, denyBatchResponse
+	case *pb.KeyRequest:
+		return keyservice.OperationManage, r.KeyIds, func(code int32, msg string) interface{} {
+			return &pb.KeyResponse{Code: code, Msg: msg}
+		}
+	case *pb.RotateRequest:
+		return keyservice.OperationManage, []string{r.KeyId}, denyResponse
+	case *pb.CreateKeyRequest:
+		return keyservice.OperationManage, []string{r.KeyId}, denyKeyMetaResponse
+	case *pb.ListKeysRequest:
+		return keyservice.OperationManage, []string{""}, func(code int32, msg string) interface{} {
+			return &pb.ListKeysResponse{Code: code, Msg: msg}
+		}
+	case *pb.KeyIdRequest:
+		return keyservice.OperationManage, []string{r.KeyId}, denyKeyMetaResponse
+	case *pb.ScheduleKeyDeletionRequest:
+		return keyservice.OperationManage, []string{r.KeyId}, denyKeyMetaResponse
+	case *pb.GenerateDataKeyRequest:
+		return keyservice.OperationEncrypt, []string{r.KeyId}, denyDataKeyResponse
+	case *pb.UnwrapDataKeyRequest:
+		return keyservice.OperationDecrypt, []string{r.KeyId}, denyDataKeyResponse
+	case *pb.BlindIndexRequest:
+		return keyservice.OperationEncrypt, []string{r.KeyId}, func(code int32, msg string) interface{} {
+			return &pb.BlindIndexResponse{Code: code, Msg: msg}
+		}
+	case *pb.BlindIndexBatchRequest:
+		for _, item := range r.Items {
+			keyIDs = append(keyIDs, item.KeyId)
+		}
+		return keyservice.OperationEncrypt, keyIDs, func(code int32, msg string) interface{} {
+			return &pb.BlindIndexBatchResponse{Code: code, Msg: msg}
+		}
+	case *pb.TokenizeRequest:
+		return keyservice.OperationEncrypt, []string{r.KeyId}, func(code int32, msg string) interface{} {
+			return &pb.TokenizeResponse{Code: code, Msg: msg}
+		}
+	case *pb.DetokenizeRequest:
+		return keyservice.OperationDecrypt, []string{r.KeyId}, denyResponse
+	}
+	return
+}
+
+// decryptOperation returns OperationDecryptMasked if only masked plaintext is returned
+func decryptOperation(r *pb.DecryptRequest) keyservice.Operation {
+	if r.Mask != "" {
+		return keyservice.OperationDecryptMasked
+	}
+	return keyservice.OperationDecrypt
+}
+
+func denyResponse(code int32, msg string) interface{} {
+	return &pb.Response{Code: code, Msg: msg}
+}
+
+func denyBatchResponse(code int32, msg string) interface{} {
+	return &pb.BatchResponse{Code: code, Msg: msg}
+}
+
+func denyKeyMetaResponse(code int32, msg string) interface{} {
+	return &pb.KeyMetaResponse{Code: code, Msg: msg}
+}
+
+func denyDataKeyResponse(code int32, msg string) interface{} {
+	return &pb.DataKeyResponse{Code: code, Msg: msg}
+}
@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/techxmind/keyservice"
+	pb "github.com/techxmind/keyservice/interface-defs"
+)
+
+type codeResponse interface {
+	GetCode() int32
+}
+
+// requestCases covers every request type of Authorize and Authenticate,
+// req returns request on the key, resp is the type of denied response.
+var requestCases = []struct {
+	name string
+	op   keyservice.Operation
+	req  func(keyID string) interface{}
+	resp interface{}
+}{
+	{"Encrypt", keyservice.OperationEncrypt, func(id string) interface{} {
+		return &pb.EncryptRequest{KeyId: id}
+	}, &pb.Response{}},
+	{"EncryptBatch", keyservice.OperationEncrypt, func(id string) interface{} {
+		return &pb.EncryptBatchRequest{Items: []*pb.EncryptRequest{{KeyId: "app.key"}, {KeyId: id}}}
+	}, &pb.BatchResponse{}},
+	{"Decrypt", keyservice.OperationDecrypt, func(id string) interface{} {
+		return &pb.DecryptRequest{KeyId: id}
+	}, &pb.Response{}},
+	{"DecryptBatch", keyservice.OperationDecrypt, func(id string) interface{} {
+		return &pb.DecryptBatchRequest{Items: []*pb.DecryptRequest{{KeyId: "app.key"}, {KeyId: id}}}
+	}, &pb.BatchResponse{}},
+	{"Keys", keyservice.OperationManage, func(id string) interface{} {
+		return &pb.KeyRequest{KeyIds: []string{"app.key", id}}
+	}, &pb.KeyResponse{}},
+	{"Rotate", keyservice.OperationManage, func(id string) interface{} {
+		return &pb.RotateRequest{KeyId: id}
+	}, &pb.Response{}},
+	{"CreateKey", keyservice.OperationManage, func(id string) interface{} {
+		return &pb.CreateKeyRequest{KeyId: id}
+	}, &pb.KeyMetaResponse{}},
+	{"ListKeys", keyservice.OperationManage, func(string) interface{} {
+		return &pb.ListKeysRequest{}
+	}, &pb.ListKeysResponse{}},
+	{"DescribeKey", keyservice.OperationManage, func(id string) interface{} {
+		return &pb.KeyIdRequest{KeyId: id}
+	}, &pb.KeyMetaResponse{}},
+	{"ScheduleKeyDeletion", keyservice.OperationManage, func(id string) interface{} {
+		return &pb.ScheduleKeyDeletionRequest{KeyId: id}
+	}, &pb.KeyMetaResponse{}},
+	{"GenerateDataKey", keyservice.OperationEncrypt, func(id string) interface{} {
+		return &pb.GenerateDataKeyRequest{KeyId: id}
+	}, &pb.DataKeyResponse{}},
+	{"UnwrapDataKey", keyservice.OperationDecrypt, func(id string) interface{} {
+		return &pb.UnwrapDataKeyRequest{KeyId: id}
+	}, &pb.DataKeyResponse{}},
+	{"BlindIndex", keyservice.OperationEncrypt, func(id string) interface{} {
+		return &pb.BlindIndexRequest{KeyId: id}
+	}, &pb.BlindIndexResponse{}},
+	{"BlindIndexBatch", keyservice.OperationEncrypt, func(id string) interface{} {
+		return &pb.BlindIndexBatchRequest{Items: []*pb.BlindIndexRequest{{KeyId: "app.key"}, {KeyId: id}}}
+	}, &pb.BlindIndexBatchResponse{}},
+	{"Tokenize", keyservice.OperationEncrypt, func(id string) interface{} {
+		return &pb.TokenizeRequest{KeyId: id}
+	}, &pb.TokenizeResponse{}},
+	{"Detokenize", keyservice.OperationDecrypt, func(id string) interface{} {
+		return &pb.DetokenizeRequest{KeyId: id}
+	}, &pb.Response{}},
+}
+
+// okEndpoint records whether it's called
+type okEndpoint struct {
+	called bool
+}
+
+func (e *okEndpoint) endpoint(context.Context, interface{}) (interface{}, error) {
+	e.called = true
+	return &pb.Response{}, nil
+}
+
+func TestAuthorize(t *testing.T) {
+	SetPolicies(keyservice.Policies{
+		{Callers: []string{"cert:app"}, Keys: []string{"app.*"}, Operations: []keyservice.Operation{keyservice.OperationEncrypt, keyservice.OperationDecrypt}},
+		{Callers: []string{"cert:admin"}, Keys: []string{"*"}, Operations: []keyservice.Operation{keyservice.OperationManage}},
+	})
+	defer SetPolicies(nil)
+
+	for _, c := range requestCases {
+		t.Run(c.name, func(t *testing.T) {
+			caller, other := "cert:app", "cert:admin"
+			if c.op == keyservice.OperationManage {
+				caller, other = other, caller
+			}
+
+			// allowed
+			next := &okEndpoint{}
+			resp, err := Authorize(next.endpoint)(keyservice.ContextWithCaller(context.Background(), caller), c.req("app.key"))
+			require.NoError(t, err)
+			assert.True(t, next.called)
+			assert.Equal(t, CodeOK, resp.(codeResponse).GetCode())
+
+			denied := map[string]context.Context{
+				"anonymous":        context.Background(),
+				"operation denied": keyservice.ContextWithCaller(context.Background(), other),
+			}
+			for name, ctx := range denied {
+				next := &okEndpoint{}
+				resp, err := Authorize(next.endpoint)(ctx, c.req("app.key"))
+				require.NoError(t, err, name)
+				assert.False(t, next.called, name)
+				assert.IsType(t, c.resp, resp, name)
+				assert.Equal(t, CodePermissionDenied, resp.(codeResponse).GetCode(), name)
+			}
+
+			// key denied
+			if c.op != keyservice.OperationManage {
+				next := &okEndpoint{}
+				resp, err := Authorize(next.endpoint)(keyservice.ContextWithCaller(context.Background(), caller), c.req("other.key"))
+				require.NoError(t, err)
+				assert.False(t, next.called)
+				assert.IsType(t, c.resp, resp)
+				assert.Equal(t, CodePermissionDenied, resp.(codeResponse).GetCode())
+			}
+		})
+	}
+
+	// unknown request is denied
+	next := &okEndpoint{}
+	_, err := Authorize(next.endpoint)(keyservice.ContextWithCaller(context.Background(), "cert:admin"), &pb.Empty{})
+	assert.Equal(t, ErrPermissionDenied, err)
+	assert.False(t, next.called)
+
+	// all callers are allowed if authorization is disabled, except unknown request
+	SetPolicies(nil)
+	for _, c := range requestCases {
+		next := &okEndpoint{}
+		_, err := Authorize(next.endpoint)(context.Background(), c.req("other.key"))
+		require.NoError(t, err)
+		assert.True(t, next.called, c.name)
+	}
+	_, err = Authorize(next.endpoint)(context.Background(), struct{}{})
+	assert.Equal(t, ErrPermissionDenied, err)
+}
+
+func TestAuthorizationScope(t *testing.T) {
+	for _, c := range requestCases {
+		op, keyIDs, deny := authorizationScope(c.req("app.key"))
+		assert.Equal(t, c.op, op, c.name)
+		assert.NotEmpty(t, keyIDs, c.name)
+		require.NotNil(t, deny, c.name)
+		assert.IsType(t, c.resp, deny(CodePermissionDenied, ""), c.name)
+	}
+
+	_, keyIDs, deny := authorizationScope(&pb.Empty{})
+	assert.Empty(t, keyIDs)
+	assert.Nil(t, deny)
+}
+
+func TestAuthorizeDecryptMasked(t *testing.T) {
+	SetPolicies(keyservice.Policies{
+		{Callers: []string{"cert:support"}, Keys: []string{"*"}, Operations: []keyservice.Operation{keyservice.OperationDecryptMasked}},
+	})
+	defer SetPolicies(nil)
+
+	ctx := keyservice.ContextWithCaller(context.Background(), "cert:support")
+	cases := []struct {
+		req     interface{}
+		allowed bool
+	}{
+		{&pb.DecryptRequest{KeyId: "key1", Mask: keyservice.MaskLast4}, true},
+		{&pb.DecryptRequest{KeyId: "key1", RawCipher: []byte("raw"), Mask: keyservice.MaskLast4}, true},
+		{&pb.DecryptRequest{KeyId: "key1"}, false},
+		{&pb.DecryptBatchRequest{Items: []*pb.DecryptRequest{{KeyId: "key1", Mask: keyservice.MaskFull}, {KeyId: "key2", Mask: keyservice.MaskName}}}, true},
+		{&pb.DecryptBatchRequest{Items: []*pb.DecryptRequest{{KeyId: "key1", Mask: keyservice.MaskFull}, {KeyId: "key2"}}}, false},
+		{&pb.UnwrapDataKeyRequest{KeyId: "key1"}, false},
+		{&pb.DetokenizeRequest{KeyId: "key1"}, false},
+		{&pb.EncryptRequest{KeyId: "key1"}, false},
+	}
+	for _, c := range cases {
+		next := &okEndpoint{}
+		resp, err := Authorize(next.endpoint)(ctx, c.req)
+		require.NoError(t, err)
+		assert.Equal(t, c.allowed, next.called, "%+v", c.req)
+		if !c.allowed {
+			assert.Equal(t, CodePermissionDenied, resp.(codeResponse).GetCode(), "%+v", c.req)
+		}
+	}
+}
+
+func TestDecryptMasked(t *testing.T) {
+	ks := keyservice.NewKeyService("seed-key", mapStorage{"key1": keyservice.NewKey("key1-value")}, keyservice.NoCache)
+	s := NewService(ks)
+	ctx := context.Background()
+
+	raw, err := ks.EncryptBytes([]byte("13800138000"), "key1")
+	require.NoError(t, err)
+	resp, err := s.Decrypt(ctx, &pb.DecryptRequest{KeyId: "key1", RawCipher: raw, Mask: keyservice.MaskFirst3Last4})
+	require.NoError(t, err)
+	assert.Equal(t, CodeOK, resp.Code)
+	assert.Equal(t, "138****8000", string(resp.RawResult))
+
+	// mask policy is checked before decryption
+	resp, err = s.Decrypt(ctx, &pb.DecryptRequest{KeyId: "key1", RawCipher: []byte("invalid"), Mask: "not-exists"})
+	require.NoError(t, err)
+	assert.Equal(t, CodeInvalidArgument, resp.Code)
+	assert.Empty(t, resp.RawResult)
+
+	resp, err = s.Decrypt(ctx, &pb.DecryptRequest{KeyId: "key1", Cipher: "invalid", Mask: "not-exists"})
+	require.NoError(t, err)
+	assert.Equal(t, CodeInvalidArgument, resp.Code)
+}
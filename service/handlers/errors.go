@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/techxmind/keyservice"
+	pb "github.com/techxmind/keyservice/interface-defs"
+)
+
+// Response codes, stable across releases.
+// 0 means success, codes below 1000 follow http status semantics.
+const (
+	CodeOK                   int32 = 0
+	CodeUnauthenticated      int32 = 401
+	CodePermissionDenied     int32 = 403
+	CodeInternalError        int32 = 500
+	CodeNotImplemented       int32 = 501
+	CodeServiceUnavailable   int32 = 503
+	CodeInvalidArgument      int32 = 1000
+	CodeKeyNotFound          int32 = 1001
+	CodeInvalidEncryptedData int32 = 1002
+	CodeSignatureError       int32 = 1003
+	CodeKeyDisabled          int32 = 1004
+	CodeKeyExists            int32 = 1005
+)
+
+var (
+	ErrKeyIDRequired    = errors.New("key_id required")
+	ErrPermissionDenied = errors.New("Permission denied")
+	ErrUnauthenticated  = errors.New("Unauthenticated")
+)
+
+// errorCode maps err to response code and message
+func errorCode(err error) (int32, string) {
+	switch err {
+	case nil:
+		return CodeOK, ""
+	case ErrKeyIDRequired:
+		return CodeInvalidArgument, err.Error()
+	case ErrUnauthenticated, keyservice.ErrInvalidToken, keyservice.ErrTokenExpired:
+		return CodeUnauthenticated, err.Error()
+	case ErrPermissionDenied:
+		return CodePermissionDenied, err.Error()
+	case keyservice.ErrNotFound:
+		return CodeKeyNotFound, err.Error()
+	case keyservice.ErrInvalidEncryptedData, keyservice.ErrInvalidDataKey:
+		return CodeInvalidEncryptedData, err.Error()
+	case keyservice.ErrSignatureError:
+		return CodeSignatureError, err.Error()
+	case keyservice.ErrKeyDisabled:
+		return CodeKeyDisabled, err.Error()
+	case keyservice.ErrKeyExists:
+		return CodeKeyExists, err.Error()
+	case keyservice.ErrInvalidPendingWindow, keyservice.ErrInvalidMode, keyservice.ErrInvalidTokenizeValue, keyservice.ErrUnknownMaskPolicy:
+		return CodeInvalidArgument, err.Error()
+	case keyservice.ErrMethodNotImplemented, keyservice.ErrStorageNotIterable, keyservice.ErrStorageNotDeletable:
+		return CodeNotImplemented, err.Error()
+	case keyservice.ErrStorageUnavailable:
+		return CodeServiceUnavailable, err.Error()
+	}
+
+	return CodeInternalError, err.Error()
+}
+
+func setError(resp *pb.Response, err error) {
+	resp.Code, resp.Msg = errorCode(err)
+}
@@ -2,43 +2,365 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
 
+	"github.com/techxmind/keyservice"
 	pb "github.com/techxmind/keyservice/interface-defs"
 )
 
-// NewService returns a naïve, stateless implementation of Service.
-func NewService() pb.KeyServiceServer {
-	return keyserviceService{}
+// NewService returns a KeyServiceServer backed by the given *keyservice.KeyService.
+func NewService(ks *keyservice.KeyService) pb.KeyServiceServer {
+	return keyserviceService{
+		ks: ks,
+	}
 }
 
-type keyserviceService struct{}
+type keyserviceService struct {
+	ks *keyservice.KeyService
+}
 
 func (s keyserviceService) Encrypt(ctx context.Context, in *pb.EncryptRequest) (*pb.Response, error) {
 	var resp pb.Response
+	if in.KeyId == "" {
+		setError(&resp, ErrKeyIDRequired)
+		return &resp, nil
+	}
+	if len(in.RawData) > 0 {
+		encrypt := s.ks.EncryptBytesWithContext
+		if in.Deterministic {
+			encrypt = s.ks.EncryptBytesDeterministic
+		}
+		result, err := encrypt(in.RawData, in.KeyId, in.Context)
+		if err != nil {
+			setError(&resp, err)
+			return &resp, nil
+		}
+		resp.RawResult = result
+		return &resp, nil
+	}
+	encrypt := s.ks.EncryptWithContext
+	if in.Deterministic {
+		encrypt = s.ks.EncryptDeterministic
+	}
+	result, err := encrypt(in.Data, in.KeyId, in.Context)
+	if err != nil {
+		setError(&resp, err)
+		return &resp, nil
+	}
+	resp.Result = result
 	return &resp, nil
 }
 
 func (s keyserviceService) EncryptBatch(ctx context.Context, in *pb.EncryptBatchRequest) (*pb.BatchResponse, error) {
 	var resp pb.BatchResponse
+	resp.Results = make([]*pb.Response, 0, len(in.Items))
+	for _, item := range in.Items {
+		r, _ := s.Encrypt(ctx, item)
+		resp.Results = append(resp.Results, r)
+	}
 	return &resp, nil
 }
 
 func (s keyserviceService) Decrypt(ctx context.Context, in *pb.DecryptRequest) (*pb.Response, error) {
 	var resp pb.Response
+	if in.KeyId == "" {
+		setError(&resp, ErrKeyIDRequired)
+		return &resp, nil
+	}
+	if len(in.RawCipher) > 0 {
+		var (
+			result []byte
+			err    error
+		)
+		if in.Mask != "" {
+			var masked string
+			masked, err = s.ks.DecryptBytesMasked(in.RawCipher, in.KeyId, in.Context, in.Mask)
+			result = []byte(masked)
+		} else {
+			result, err = s.ks.DecryptBytesWithContext(in.RawCipher, in.KeyId, in.Context)
+		}
+		if err != nil {
+			setError(&resp, err)
+			return &resp, nil
+		}
+		resp.RawResult = result
+		return &resp, nil
+	}
+	var (
+		result string
+		err    error
+	)
+	if in.Mask != "" {
+		result, err = s.ks.DecryptMasked(in.Cipher, in.KeyId, in.Context, in.Mask)
+	} else {
+		result, err = s.ks.DecryptWithContext(in.Cipher, in.KeyId, in.Context)
+	}
+	if err != nil {
+		setError(&resp, err)
+		return &resp, nil
+	}
+	resp.Result = result
 	return &resp, nil
 }
 
 func (s keyserviceService) DecryptBatch(ctx context.Context, in *pb.DecryptBatchRequest) (*pb.BatchResponse, error) {
 	var resp pb.BatchResponse
+	resp.Results = make([]*pb.Response, 0, len(in.Items))
+	for _, item := range in.Items {
+		r, _ := s.Decrypt(ctx, item)
+		resp.Results = append(resp.Results, r)
+	}
 	return &resp, nil
 }
 
 func (s keyserviceService) Keys(ctx context.Context, in *pb.KeyRequest) (*pb.KeyResponse, error) {
 	var resp pb.KeyResponse
+	if len(in.KeyIds) == 0 {
+		resp.Code, resp.Msg = errorCode(ErrKeyIDRequired)
+		return &resp, nil
+	}
+	keys, err := s.ks.GetKeys(in.KeyIds)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err)
+		return &resp, nil
+	}
+	resp.Result = make(map[string]string, len(keys))
+	for id, key := range keys {
+		data, err := json.Marshal(key)
+		if err != nil {
+			resp.Code, resp.Msg = errorCode(err)
+			return &resp, nil
+		}
+		resp.Result[id] = string(data)
+	}
 	return &resp, nil
 }
 
 func (s keyserviceService) Ping(ctx context.Context, in *pb.Empty) (*pb.Response, error) {
 	var resp pb.Response
+	resp.Msg = "pong"
+	// keys are served from cache while storage is unavailable
+	if s.ks.Health().Degraded {
+		resp.Result = "degraded"
+	}
+	return &resp, nil
+}
+
+func (s keyserviceService) Rotate(ctx context.Context, in *pb.RotateRequest) (*pb.Response, error) {
+	var resp pb.Response
+	if in.KeyId == "" {
+		setError(&resp, ErrKeyIDRequired)
+		return &resp, nil
+	}
+	key, err := s.ks.RotateKey(in.KeyId)
+	if err != nil {
+		setError(&resp, err)
+		return &resp, nil
+	}
+	resp.Result = strconv.Itoa(int(key.Version))
+	return &resp, nil
+}
+
+func (s keyserviceService) CreateKey(ctx context.Context, in *pb.CreateKeyRequest) (*pb.KeyMetaResponse, error) {
+	var resp pb.KeyMetaResponse
+	if in.KeyId == "" {
+		resp.Code, resp.Msg = errorCode(ErrKeyIDRequired)
+		return &resp, nil
+	}
+	key, err := s.ks.CreateKey(in.KeyId, keyservice.KeyOptions{
+		RotationPeriod: in.RotationPeriod,
+		Mode:           keyservice.EncryptionMode(in.Mode),
+	})
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err)
+		return &resp, nil
+	}
+	resp.Result = keyMeta(key.Metadata(in.KeyId))
+	return &resp, nil
+}
+
+func (s keyserviceService) ListKeys(ctx context.Context, in *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
+	var resp pb.ListKeysResponse
+	keys, nextPageToken, err := s.ks.ListKeys(in.PageToken, int(in.PageSize))
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err)
+		return &resp, nil
+	}
+	resp.Result = make([]*pb.KeyMeta, len(keys))
+	for i, key := range keys {
+		resp.Result[i] = keyMeta(key)
+	}
+	resp.NextPageToken = nextPageToken
+	return &resp, nil
+}
+
+func (s keyserviceService) DescribeKey(ctx context.Context, in *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	var resp pb.KeyMetaResponse
+	if in.KeyId == "" {
+		resp.Code, resp.Msg = errorCode(ErrKeyIDRequired)
+		return &resp, nil
+	}
+	meta, err := s.ks.DescribeKey(in.KeyId)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err)
+		return &resp, nil
+	}
+	resp.Result = keyMeta(meta)
+	return &resp, nil
+}
+
+func (s keyserviceService) DisableKey(ctx context.Context, in *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	return s.updateKey(in.KeyId, s.ks.DisableKey)
+}
+
+func (s keyserviceService) EnableKey(ctx context.Context, in *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	return s.updateKey(in.KeyId, s.ks.EnableKey)
+}
+
+func (s keyserviceService) ScheduleKeyDeletion(ctx context.Context, in *pb.ScheduleKeyDeletionRequest) (*pb.KeyMetaResponse, error) {
+	return s.updateKey(in.KeyId, func(id string) (*keyservice.Key, error) {
+		return s.ks.ScheduleKeyDeletion(id, time.Duration(in.PendingWindow)*time.Second)
+	})
+}
+
+func (s keyserviceService) GenerateDataKey(ctx context.Context, in *pb.GenerateDataKeyRequest) (*pb.DataKeyResponse, error) {
+	var resp pb.DataKeyResponse
+	if in.KeyId == "" {
+		resp.Code, resp.Msg = errorCode(ErrKeyIDRequired)
+		return &resp, nil
+	}
+	plaintext, wrapped, err := s.ks.GenerateDataKeyWithContext(in.KeyId, in.Context)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err)
+		return &resp, nil
+	}
+	resp.Plaintext = plaintext
+	resp.Wrapped = wrapped
+	return &resp, nil
+}
+
+func (s keyserviceService) UnwrapDataKey(ctx context.Context, in *pb.UnwrapDataKeyRequest) (*pb.DataKeyResponse, error) {
+	var resp pb.DataKeyResponse
+	if in.KeyId == "" {
+		resp.Code, resp.Msg = errorCode(ErrKeyIDRequired)
+		return &resp, nil
+	}
+	plaintext, err := s.ks.UnwrapDataKeyWithContext(in.Wrapped, in.KeyId, in.Context)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err)
+		return &resp, nil
+	}
+	resp.Plaintext = plaintext
+	resp.Wrapped = in.Wrapped
+	return &resp, nil
+}
+
+func (s keyserviceService) BlindIndex(ctx context.Context, in *pb.BlindIndexRequest) (*pb.BlindIndexResponse, error) {
+	var resp pb.BlindIndexResponse
+	if in.KeyId == "" {
+		resp.Code, resp.Msg = errorCode(ErrKeyIDRequired)
+		return &resp, nil
+	}
+	if in.AllVersions {
+		indexes, err := s.ks.BlindIndexes(in.Value, in.KeyId)
+		if err != nil {
+			resp.Code, resp.Msg = errorCode(err)
+			return &resp, nil
+		}
+		resp.Indexes = indexes
+	}
+	result, err := s.ks.BlindIndex(in.Value, in.KeyId)
+	if err != nil {
+		// 密钥已不能用于生成新索引时，仍返回旧版本的索引用于查询
+		if !in.AllVersions || err != keyservice.ErrKeyDisabled {
+			resp.Code, resp.Msg = errorCode(err)
+		}
+		return &resp, nil
+	}
+	resp.Result = result
+	return &resp, nil
+}
+
+func (s keyserviceService) BlindIndexBatch(ctx context.Context, in *pb.BlindIndexBatchRequest) (*pb.BlindIndexBatchResponse, error) {
+	var resp pb.BlindIndexBatchResponse
+	resp.Results = make([]*pb.BlindIndexResponse, 0, len(in.Items))
+	for _, item := range in.Items {
+		r, _ := s.BlindIndex(ctx, item)
+		resp.Results = append(resp.Results, r)
+	}
+	return &resp, nil
+}
+
+func (s keyserviceService) Tokenize(ctx context.Context, in *pb.TokenizeRequest) (*pb.TokenizeResponse, error) {
+	var resp pb.TokenizeResponse
+	if in.KeyId == "" {
+		resp.Code, resp.Msg = errorCode(ErrKeyIDRequired)
+		return &resp, nil
+	}
+	token, version, err := s.ks.Tokenize(in.Value, in.KeyId)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err)
+		return &resp, nil
+	}
+	resp.Result = token
+	resp.Version = uint32(version)
+	return &resp, nil
+}
+
+func (s keyserviceService) Detokenize(ctx context.Context, in *pb.DetokenizeRequest) (*pb.Response, error) {
+	var resp pb.Response
+	if in.KeyId == "" {
+		setError(&resp, ErrKeyIDRequired)
+		return &resp, nil
+	}
+	if in.Version > math.MaxUint16 {
+		setError(&resp, keyservice.ErrInvalidEncryptedData)
+		return &resp, nil
+	}
+	result, err := s.ks.Detokenize(in.Token, in.KeyId, uint16(in.Version))
+	if err != nil {
+		setError(&resp, err)
+		return &resp, nil
+	}
+	resp.Result = result
 	return &resp, nil
 }
+
+func (s keyserviceService) updateKey(id string, fn func(id string) (*keyservice.Key, error)) (*pb.KeyMetaResponse, error) {
+	var resp pb.KeyMetaResponse
+	if id == "" {
+		resp.Code, resp.Msg = errorCode(ErrKeyIDRequired)
+		return &resp, nil
+	}
+	key, err := fn(id)
+	if err != nil {
+		resp.Code, resp.Msg = errorCode(err)
+		return &resp, nil
+	}
+	resp.Result = keyMeta(key.Metadata(id))
+	return &resp, nil
+}
+
+func keyMeta(m *keyservice.KeyMetadata) *pb.KeyMeta {
+	meta := &pb.KeyMeta{
+		KeyId:          m.ID,
+		Version:        uint32(m.Version),
+		State:          string(m.State),
+		RotationPeriod: m.RotationPeriod,
+		DeletionTime:   m.DeletionTime,
+		Mode:           string(m.Mode),
+		Versions:       make([]*pb.KeyVersionMeta, len(m.Versions)),
+	}
+	for i, v := range m.Versions {
+		meta.Versions[i] = &pb.KeyVersionMeta{
+			Version:   uint32(v.Version),
+			State:     string(v.State),
+			CreatedAt: v.CreatedAt,
+			UpdatedAt: v.UpdatedAt,
+		}
+	}
+	return meta
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/techxmind/keyservice"
+)
+
+// HealthHandler returns http handler of health status, e.g.
+//
+//	{"status":"degraded","health":{"degraded":true,"circuit_open":true,...}}
+//
+// status is "ok" or "degraded", degraded service still serves cached keys, so it responds 200 either way.
+func HealthHandler(ks *keyservice.KeyService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		health := ks.Health()
+		status := "ok"
+		if health.Degraded {
+			status = "degraded"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"status": status,
+			"health": health,
+		})
+	})
+}
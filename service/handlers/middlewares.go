@@ -31,6 +31,15 @@ func WrapEndpoints(in svc.Endpoints) svc.Endpoints {
 	// How to apply a middleware to a single endpoint.
 	// in.ExampleEndpoint = authMiddleware(in.ExampleEndpoint)
 
+	// Check policies of callers, see SetPolicies.
+	in.WrapAllExcept(Authorize, "Ping")
+	// Reject unauthenticated callers before authorization, see SetTokenAuthenticator.
+	in.WrapAllExcept(Authenticate, "Ping")
+	// Audit log of key operations including rejected ones, see SetAuditor.
+	in.WrapAllLabeledExcept(Audit, "Ping")
+	// Request count and latency by caller.
+	in.WrapAllLabeledExcept(metrics.RequestMetrics)
+
 	return in
 }
 
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/techxmind/keyservice"
+	pb "github.com/techxmind/keyservice/interface-defs"
+)
+
+// 流式响应的数据分片大小
+var streamChunkSize = 32 * 1024
+
+// NewStreamService returns a KeyStreamServiceServer backed by the given *keyservice.KeyService.
+// truss doesn't support streaming RPCs, so it's registered on the gRPC server directly.
+//
+// The first request message carries key_id and context, the last response message carries
+// a non-zero code if the stream failed, callers must discard the received data in that case.
+func NewStreamService(ks *keyservice.KeyService) pb.KeyStreamServiceServer {
+	return keyserviceStreamService{
+		ks: ks,
+	}
+}
+
+type keyserviceStreamService struct {
+	ks *keyservice.KeyService
+}
+
+func (s keyserviceStreamService) EncryptStream(ss pb.KeyStreamService_EncryptStreamServer) (err error) {
+	in, err := ss.Recv()
+	if err != nil {
+		return err
+	}
+
+	ctx := streamContext(ss.Context())
+	stream := newAuditStream(ctx, ss, "EncryptStream", in.KeyId, true)
+	defer func() {
+		stream.audit(err)
+	}()
+
+	if in.KeyId == "" {
+		return sendStreamError(stream, ErrKeyIDRequired)
+	}
+	if err = streamAccess(ctx, keyservice.OperationEncrypt, in.KeyId); err != nil {
+		return sendStreamError(stream, err)
+	}
+
+	w, err := s.ks.NewEncryptWriter(streamWriter{stream}, in.KeyId, in.Context)
+	if err != nil {
+		return sendStreamError(stream, err)
+	}
+
+	r := &streamReader{stream: stream, data: in.Data}
+	buf := make([]byte, streamChunkSize)
+	if _, err = io.CopyBuffer(w, r, buf); err != nil {
+		if r.err != nil {
+			return r.err
+		}
+		return sendStreamError(stream, err)
+	}
+
+	if err = w.Close(); err != nil {
+		return sendStreamError(stream, err)
+	}
+
+	return nil
+}
+
+func (s keyserviceStreamService) DecryptStream(ss pb.KeyStreamService_DecryptStreamServer) (err error) {
+	in, err := ss.Recv()
+	if err != nil {
+		return err
+	}
+
+	ctx := streamContext(ss.Context())
+	stream := newAuditStream(ctx, ss, "DecryptStream", in.KeyId, false)
+	defer func() {
+		stream.audit(err)
+	}()
+	stream.recordHead(in.Data)
+
+	if in.KeyId == "" {
+		return sendStreamError(stream, ErrKeyIDRequired)
+	}
+	if err = streamAccess(ctx, keyservice.OperationDecrypt, in.KeyId); err != nil {
+		return sendStreamError(stream, err)
+	}
+
+	sr := &streamReader{stream: stream, data: in.Data}
+	r, err := s.ks.NewDecryptReader(sr, in.KeyId, in.Context)
+	if err != nil {
+		if sr.err != nil {
+			return sr.err
+		}
+		return sendStreamError(stream, err)
+	}
+
+	buf := make([]byte, streamChunkSize)
+	if _, err = io.CopyBuffer(streamWriter{stream}, r, buf); err != nil {
+		if sr.err != nil {
+			return sr.err
+		}
+		return sendStreamError(stream, err)
+	}
+
+	return nil
+}
+
+// streamContext puts caller identity and request id to context of stream, streams don't go through endpoints
+func streamContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return GRPCRequestIDToContext(GRPCCallerToContext(ctx, md), md)
+}
+
+// streamAccess checks authentication and authorization of stream
+func streamAccess(ctx context.Context, op keyservice.Operation, keyID string) error {
+	if err := authenticated(ctx); err != nil {
+		return err
+	}
+	if !authorized(ctx, op, keyID) {
+		return ErrPermissionDenied
+	}
+	return nil
+}
+
+type streamServer interface {
+	Send(*pb.StreamResponse) error
+	Recv() (*pb.StreamRequest, error)
+}
+
+func sendStreamError(stream streamServer, err error) error {
+	var resp pb.StreamResponse
+	resp.Code, resp.Msg = errorCode(err)
+	return stream.Send(&resp)
+}
+
+// streamWriter sends written data as response messages
+type streamWriter struct {
+	stream streamServer
+}
+
+func (w streamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.StreamResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// streamReader reads data of request messages
+type streamReader struct {
+	stream streamServer
+	data   []byte
+	// 接收消息的错误，需要直接返回给gRPC
+	err error
+}
+
+func (r *streamReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		in, err := r.stream.Recv()
+		if err == io.EOF {
+			return 0, io.EOF
+		}
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		r.data = in.Data
+	}
+
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
@@ -101,6 +101,175 @@ func New(conn *grpc.ClientConn, options ...ClientOption) (pb.KeyServiceServer, e
 		).Endpoint()
 	}
 
+	var rotateEndpoint endpoint.Endpoint
+	{
+		rotateEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"Rotate",
+			EncodeGRPCRotateRequest,
+			DecodeGRPCRotateResponse,
+			pb.Response{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var createkeyEndpoint endpoint.Endpoint
+	{
+		createkeyEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"CreateKey",
+			EncodeGRPCCreateKeyRequest,
+			DecodeGRPCCreateKeyResponse,
+			pb.KeyMetaResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var listkeysEndpoint endpoint.Endpoint
+	{
+		listkeysEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"ListKeys",
+			EncodeGRPCListKeysRequest,
+			DecodeGRPCListKeysResponse,
+			pb.ListKeysResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var describekeyEndpoint endpoint.Endpoint
+	{
+		describekeyEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"DescribeKey",
+			EncodeGRPCDescribeKeyRequest,
+			DecodeGRPCDescribeKeyResponse,
+			pb.KeyMetaResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var disablekeyEndpoint endpoint.Endpoint
+	{
+		disablekeyEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"DisableKey",
+			EncodeGRPCDisableKeyRequest,
+			DecodeGRPCDisableKeyResponse,
+			pb.KeyMetaResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var enablekeyEndpoint endpoint.Endpoint
+	{
+		enablekeyEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"EnableKey",
+			EncodeGRPCEnableKeyRequest,
+			DecodeGRPCEnableKeyResponse,
+			pb.KeyMetaResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var schedulekeydeletionEndpoint endpoint.Endpoint
+	{
+		schedulekeydeletionEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"ScheduleKeyDeletion",
+			EncodeGRPCScheduleKeyDeletionRequest,
+			DecodeGRPCScheduleKeyDeletionResponse,
+			pb.KeyMetaResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var generatedatakeyEndpoint endpoint.Endpoint
+	{
+		generatedatakeyEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"GenerateDataKey",
+			EncodeGRPCGenerateDataKeyRequest,
+			DecodeGRPCGenerateDataKeyResponse,
+			pb.DataKeyResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var unwrapdatakeyEndpoint endpoint.Endpoint
+	{
+		unwrapdatakeyEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"UnwrapDataKey",
+			EncodeGRPCUnwrapDataKeyRequest,
+			DecodeGRPCUnwrapDataKeyResponse,
+			pb.DataKeyResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var blindindexEndpoint endpoint.Endpoint
+	{
+		blindindexEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"BlindIndex",
+			EncodeGRPCBlindIndexRequest,
+			DecodeGRPCBlindIndexResponse,
+			pb.BlindIndexResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var blindindexbatchEndpoint endpoint.Endpoint
+	{
+		blindindexbatchEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"BlindIndexBatch",
+			EncodeGRPCBlindIndexBatchRequest,
+			DecodeGRPCBlindIndexBatchResponse,
+			pb.BlindIndexBatchResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var tokenizeEndpoint endpoint.Endpoint
+	{
+		tokenizeEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"Tokenize",
+			EncodeGRPCTokenizeRequest,
+			DecodeGRPCTokenizeResponse,
+			pb.TokenizeResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var detokenizeEndpoint endpoint.Endpoint
+	{
+		detokenizeEndpoint = grpctransport.NewClient(
+			conn,
+			"keyservice.KeyService",
+			"Detokenize",
+			EncodeGRPCDetokenizeRequest,
+			DecodeGRPCDetokenizeResponse,
+			pb.Response{},
+			clientOptions...,
+		).Endpoint()
+	}
+
 	var pingEndpoint endpoint.Endpoint
 	{
 		pingEndpoint = grpctransport.NewClient(
@@ -115,12 +284,25 @@ func New(conn *grpc.ClientConn, options ...ClientOption) (pb.KeyServiceServer, e
 	}
 
 	return svc.Endpoints{
-		EncryptEndpoint:      encryptEndpoint,
-		EncryptBatchEndpoint: encryptbatchEndpoint,
-		DecryptEndpoint:      decryptEndpoint,
-		DecryptBatchEndpoint: decryptbatchEndpoint,
-		KeysEndpoint:         keysEndpoint,
-		PingEndpoint:         pingEndpoint,
+		EncryptEndpoint:             encryptEndpoint,
+		EncryptBatchEndpoint:        encryptbatchEndpoint,
+		DecryptEndpoint:             decryptEndpoint,
+		DecryptBatchEndpoint:        decryptbatchEndpoint,
+		KeysEndpoint:                keysEndpoint,
+		RotateEndpoint:              rotateEndpoint,
+		CreateKeyEndpoint:           createkeyEndpoint,
+		ListKeysEndpoint:            listkeysEndpoint,
+		DescribeKeyEndpoint:         describekeyEndpoint,
+		DisableKeyEndpoint:          disablekeyEndpoint,
+		EnableKeyEndpoint:           enablekeyEndpoint,
+		ScheduleKeyDeletionEndpoint: schedulekeydeletionEndpoint,
+		GenerateDataKeyEndpoint:     generatedatakeyEndpoint,
+		UnwrapDataKeyEndpoint:       unwrapdatakeyEndpoint,
+		BlindIndexEndpoint:          blindindexEndpoint,
+		BlindIndexBatchEndpoint:     blindindexbatchEndpoint,
+		TokenizeEndpoint:            tokenizeEndpoint,
+		DetokenizeEndpoint:          detokenizeEndpoint,
+		PingEndpoint:                pingEndpoint,
 	}, nil
 }
 
@@ -161,6 +343,97 @@ func DecodeGRPCKeysResponse(_ context.Context, grpcReply interface{}) (interface
 	return reply, nil
 }
 
+// DecodeGRPCRotateResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC rotate reply to a user-domain rotate response. Primarily useful in a client.
+func DecodeGRPCRotateResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.Response)
+	return reply, nil
+}
+
+// DecodeGRPCCreateKeyResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC createkey reply to a user-domain createkey response. Primarily useful in a client.
+func DecodeGRPCCreateKeyResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.KeyMetaResponse)
+	return reply, nil
+}
+
+// DecodeGRPCListKeysResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC listkeys reply to a user-domain listkeys response. Primarily useful in a client.
+func DecodeGRPCListKeysResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ListKeysResponse)
+	return reply, nil
+}
+
+// DecodeGRPCDescribeKeyResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC describekey reply to a user-domain describekey response. Primarily useful in a client.
+func DecodeGRPCDescribeKeyResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.KeyMetaResponse)
+	return reply, nil
+}
+
+// DecodeGRPCDisableKeyResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC disablekey reply to a user-domain disablekey response. Primarily useful in a client.
+func DecodeGRPCDisableKeyResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.KeyMetaResponse)
+	return reply, nil
+}
+
+// DecodeGRPCEnableKeyResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC enablekey reply to a user-domain enablekey response. Primarily useful in a client.
+func DecodeGRPCEnableKeyResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.KeyMetaResponse)
+	return reply, nil
+}
+
+// DecodeGRPCScheduleKeyDeletionResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC schedulekeydeletion reply to a user-domain schedulekeydeletion response. Primarily useful in a client.
+func DecodeGRPCScheduleKeyDeletionResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.KeyMetaResponse)
+	return reply, nil
+}
+
+// DecodeGRPCGenerateDataKeyResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC generatedatakey reply to a user-domain generatedatakey response. Primarily useful in a client.
+func DecodeGRPCGenerateDataKeyResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.DataKeyResponse)
+	return reply, nil
+}
+
+// DecodeGRPCUnwrapDataKeyResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC unwrapdatakey reply to a user-domain unwrapdatakey response. Primarily useful in a client.
+func DecodeGRPCUnwrapDataKeyResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.DataKeyResponse)
+	return reply, nil
+}
+
+// DecodeGRPCBlindIndexResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC blindindex reply to a user-domain blindindex response. Primarily useful in a client.
+func DecodeGRPCBlindIndexResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.BlindIndexResponse)
+	return reply, nil
+}
+
+// DecodeGRPCBlindIndexBatchResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC blindindexbatch reply to a user-domain blindindexbatch response. Primarily useful in a client.
+func DecodeGRPCBlindIndexBatchResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.BlindIndexBatchResponse)
+	return reply, nil
+}
+
+// DecodeGRPCTokenizeResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC tokenize reply to a user-domain tokenize response. Primarily useful in a client.
+func DecodeGRPCTokenizeResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.TokenizeResponse)
+	return reply, nil
+}
+
+// DecodeGRPCDetokenizeResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC detokenize reply to a user-domain detokenize response. Primarily useful in a client.
+func DecodeGRPCDetokenizeResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.Response)
+	return reply, nil
+}
+
 // DecodeGRPCPingResponse is a transport/grpc.DecodeResponseFunc that converts a
 // gRPC ping reply to a user-domain ping response. Primarily useful in a client.
 func DecodeGRPCPingResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
@@ -205,6 +478,97 @@ func EncodeGRPCKeysRequest(_ context.Context, request interface{}) (interface{},
 	return req, nil
 }
 
+// EncodeGRPCRotateRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain rotate request to a gRPC rotate request. Primarily useful in a client.
+func EncodeGRPCRotateRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.RotateRequest)
+	return req, nil
+}
+
+// EncodeGRPCCreateKeyRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain createkey request to a gRPC createkey request. Primarily useful in a client.
+func EncodeGRPCCreateKeyRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CreateKeyRequest)
+	return req, nil
+}
+
+// EncodeGRPCListKeysRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain listkeys request to a gRPC listkeys request. Primarily useful in a client.
+func EncodeGRPCListKeysRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ListKeysRequest)
+	return req, nil
+}
+
+// EncodeGRPCDescribeKeyRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain describekey request to a gRPC describekey request. Primarily useful in a client.
+func EncodeGRPCDescribeKeyRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.KeyIdRequest)
+	return req, nil
+}
+
+// EncodeGRPCDisableKeyRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain disablekey request to a gRPC disablekey request. Primarily useful in a client.
+func EncodeGRPCDisableKeyRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.KeyIdRequest)
+	return req, nil
+}
+
+// EncodeGRPCEnableKeyRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain enablekey request to a gRPC enablekey request. Primarily useful in a client.
+func EncodeGRPCEnableKeyRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.KeyIdRequest)
+	return req, nil
+}
+
+// EncodeGRPCScheduleKeyDeletionRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain schedulekeydeletion request to a gRPC schedulekeydeletion request. Primarily useful in a client.
+func EncodeGRPCScheduleKeyDeletionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ScheduleKeyDeletionRequest)
+	return req, nil
+}
+
+// EncodeGRPCGenerateDataKeyRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain generatedatakey request to a gRPC generatedatakey request. Primarily useful in a client.
+func EncodeGRPCGenerateDataKeyRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.GenerateDataKeyRequest)
+	return req, nil
+}
+
+// EncodeGRPCUnwrapDataKeyRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain unwrapdatakey request to a gRPC unwrapdatakey request. Primarily useful in a client.
+func EncodeGRPCUnwrapDataKeyRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.UnwrapDataKeyRequest)
+	return req, nil
+}
+
+// EncodeGRPCBlindIndexRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain blindindex request to a gRPC blindindex request. Primarily useful in a client.
+func EncodeGRPCBlindIndexRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.BlindIndexRequest)
+	return req, nil
+}
+
+// EncodeGRPCBlindIndexBatchRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain blindindexbatch request to a gRPC blindindexbatch request. Primarily useful in a client.
+func EncodeGRPCBlindIndexBatchRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.BlindIndexBatchRequest)
+	return req, nil
+}
+
+// EncodeGRPCTokenizeRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain tokenize request to a gRPC tokenize request. Primarily useful in a client.
+func EncodeGRPCTokenizeRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.TokenizeRequest)
+	return req, nil
+}
+
+// EncodeGRPCDetokenizeRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain detokenize request to a gRPC detokenize request. Primarily useful in a client.
+func EncodeGRPCDetokenizeRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.DetokenizeRequest)
+	return req, nil
+}
+
 // EncodeGRPCPingRequest is a transport/grpc.EncodeRequestFunc that converts a
 // user-domain ping request to a gRPC ping request. Primarily useful in a client.
 func EncodeGRPCPingRequest(_ context.Context, request interface{}) (interface{}, error) {
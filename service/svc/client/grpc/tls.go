@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	"crypto/tls"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// WithTLS returns dial option of TLS, use keyservice.NewClientTLSConfig for mTLS, e.g.
+//
+//	cfg, err := keyservice.NewClientTLSConfig(keyservice.TLSOptions{CertFile: ..., KeyFile: ..., CAFile: ...})
+//	conn, err := grpc.Dial(addr, client.WithTLS(cfg))
+func WithTLS(cfg *tls.Config) grpc.DialOption {
+	return grpc.WithTransportCredentials(credentials.NewTLS(cfg))
+}
@@ -100,6 +100,136 @@ func New(instance string, options ...httptransport.ClientOption) (pb.KeyServiceS
 			options...,
 		).Endpoint()
 	}
+	var RotateZeroEndpoint endpoint.Endpoint
+	{
+		RotateZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/rotate"),
+			EncodeHTTPRotateZeroRequest,
+			DecodeHTTPRotateResponse,
+			options...,
+		).Endpoint()
+	}
+	var CreateKeyZeroEndpoint endpoint.Endpoint
+	{
+		CreateKeyZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/key/create"),
+			EncodeHTTPCreateKeyZeroRequest,
+			DecodeHTTPCreateKeyResponse,
+			options...,
+		).Endpoint()
+	}
+	var ListKeysZeroEndpoint endpoint.Endpoint
+	{
+		ListKeysZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/key/list"),
+			EncodeHTTPListKeysZeroRequest,
+			DecodeHTTPListKeysResponse,
+			options...,
+		).Endpoint()
+	}
+	var DescribeKeyZeroEndpoint endpoint.Endpoint
+	{
+		DescribeKeyZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/key/describe"),
+			EncodeHTTPDescribeKeyZeroRequest,
+			DecodeHTTPDescribeKeyResponse,
+			options...,
+		).Endpoint()
+	}
+	var DisableKeyZeroEndpoint endpoint.Endpoint
+	{
+		DisableKeyZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/key/disable"),
+			EncodeHTTPDisableKeyZeroRequest,
+			DecodeHTTPDisableKeyResponse,
+			options...,
+		).Endpoint()
+	}
+	var EnableKeyZeroEndpoint endpoint.Endpoint
+	{
+		EnableKeyZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/key/enable"),
+			EncodeHTTPEnableKeyZeroRequest,
+			DecodeHTTPEnableKeyResponse,
+			options...,
+		).Endpoint()
+	}
+	var ScheduleKeyDeletionZeroEndpoint endpoint.Endpoint
+	{
+		ScheduleKeyDeletionZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/key/schedule_deletion"),
+			EncodeHTTPScheduleKeyDeletionZeroRequest,
+			DecodeHTTPScheduleKeyDeletionResponse,
+			options...,
+		).Endpoint()
+	}
+	var GenerateDataKeyZeroEndpoint endpoint.Endpoint
+	{
+		GenerateDataKeyZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/data_key/generate"),
+			EncodeHTTPGenerateDataKeyZeroRequest,
+			DecodeHTTPGenerateDataKeyResponse,
+			options...,
+		).Endpoint()
+	}
+	var UnwrapDataKeyZeroEndpoint endpoint.Endpoint
+	{
+		UnwrapDataKeyZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/data_key/unwrap"),
+			EncodeHTTPUnwrapDataKeyZeroRequest,
+			DecodeHTTPUnwrapDataKeyResponse,
+			options...,
+		).Endpoint()
+	}
+	var BlindIndexZeroEndpoint endpoint.Endpoint
+	{
+		BlindIndexZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/blind_index"),
+			EncodeHTTPBlindIndexZeroRequest,
+			DecodeHTTPBlindIndexResponse,
+			options...,
+		).Endpoint()
+	}
+	var BlindIndexBatchZeroEndpoint endpoint.Endpoint
+	{
+		BlindIndexBatchZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/blind_index_batch"),
+			EncodeHTTPBlindIndexBatchZeroRequest,
+			DecodeHTTPBlindIndexBatchResponse,
+			options...,
+		).Endpoint()
+	}
+	var TokenizeZeroEndpoint endpoint.Endpoint
+	{
+		TokenizeZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/tokenize"),
+			EncodeHTTPTokenizeZeroRequest,
+			DecodeHTTPTokenizeResponse,
+			options...,
+		).Endpoint()
+	}
+	var DetokenizeZeroEndpoint endpoint.Endpoint
+	{
+		DetokenizeZeroEndpoint = httptransport.NewClient(
+			"POST",
+			copyURL(u, "/detokenize"),
+			EncodeHTTPDetokenizeZeroRequest,
+			DecodeHTTPDetokenizeResponse,
+			options...,
+		).Endpoint()
+	}
 	var PingZeroEndpoint endpoint.Endpoint
 	{
 		PingZeroEndpoint = httptransport.NewClient(
@@ -112,12 +242,25 @@ func New(instance string, options ...httptransport.ClientOption) (pb.KeyServiceS
 	}
 
 	return svc.Endpoints{
-		EncryptEndpoint:      EncryptZeroEndpoint,
-		EncryptBatchEndpoint: EncryptBatchZeroEndpoint,
-		DecryptEndpoint:      DecryptZeroEndpoint,
-		DecryptBatchEndpoint: DecryptBatchZeroEndpoint,
-		KeysEndpoint:         KeysZeroEndpoint,
-		PingEndpoint:         PingZeroEndpoint,
+		EncryptEndpoint:             EncryptZeroEndpoint,
+		EncryptBatchEndpoint:        EncryptBatchZeroEndpoint,
+		DecryptEndpoint:             DecryptZeroEndpoint,
+		DecryptBatchEndpoint:        DecryptBatchZeroEndpoint,
+		KeysEndpoint:                KeysZeroEndpoint,
+		RotateEndpoint:              RotateZeroEndpoint,
+		CreateKeyEndpoint:           CreateKeyZeroEndpoint,
+		ListKeysEndpoint:            ListKeysZeroEndpoint,
+		DescribeKeyEndpoint:         DescribeKeyZeroEndpoint,
+		DisableKeyEndpoint:          DisableKeyZeroEndpoint,
+		EnableKeyEndpoint:           EnableKeyZeroEndpoint,
+		ScheduleKeyDeletionEndpoint: ScheduleKeyDeletionZeroEndpoint,
+		GenerateDataKeyEndpoint:     GenerateDataKeyZeroEndpoint,
+		UnwrapDataKeyEndpoint:       UnwrapDataKeyZeroEndpoint,
+		BlindIndexEndpoint:          BlindIndexZeroEndpoint,
+		BlindIndexBatchEndpoint:     BlindIndexBatchZeroEndpoint,
+		TokenizeEndpoint:            TokenizeZeroEndpoint,
+		DetokenizeEndpoint:          DetokenizeZeroEndpoint,
+		PingEndpoint:                PingZeroEndpoint,
 	}, nil
 }
 
@@ -279,12 +422,12 @@ func DecodeHTTPKeysResponse(_ context.Context, r *http.Response) (interface{}, e
 	return &resp, nil
 }
 
-// DecodeHTTPPingResponse is a transport/http.DecodeResponseFunc that decodes
+// DecodeHTTPRotateResponse is a transport/http.DecodeResponseFunc that decodes
 // a JSON-encoded Response response from the HTTP response body.
 // If the response has a non-200 status code, we will interpret that as an
 // error and attempt to decode the specific error message from the response
 // body. Primarily useful in a client.
-func DecodeHTTPPingResponse(_ context.Context, r *http.Response) (interface{}, error) {
+func DecodeHTTPRotateResponse(_ context.Context, r *http.Response) (interface{}, error) {
 	defer r.Body.Close()
 	buf, err := ioutil.ReadAll(r.Body)
 	if err == io.EOF {
@@ -306,137 +449,871 @@ func DecodeHTTPPingResponse(_ context.Context, r *http.Response) (interface{}, e
 	return &resp, nil
 }
 
-// HTTP Client Encode
-
-// EncodeHTTPEncryptZeroRequest is a transport/http.EncodeRequestFunc
-// that encodes a encrypt request into the various portions of
-// the http request (path, query, and body).
-func EncodeHTTPEncryptZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
-	strval := ""
-	_ = strval
-	req := request.(*pb.EncryptRequest)
-	_ = req
-
-	r.Header.Set("transport", "HTTPJSON")
-	r.Header.Set("request-url", r.URL.Path)
-
-	// Set the path parameters
-	path := strings.Join([]string{
-		"",
-		"encrypt",
-	}, "/")
-	u, err := url.Parse(path)
+// DecodeHTTPCreateKeyResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded KeyMetaResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPCreateKeyResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
 	if err != nil {
-		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+		return nil, errors.Wrap(err, "cannot read http body")
 	}
-	r.URL.RawPath = u.RawPath
-	r.URL.Path = u.Path
 
-	// Set the query parameters
-	values := r.URL.Query()
-	var tmp []byte
-	_ = tmp
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
 
-	r.URL.RawQuery = values.Encode()
-	// Set the body parameters
-	var buf bytes.Buffer
-	toRet := request.(*pb.EncryptRequest)
+	var resp pb.KeyMetaResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
 
-	toRet.KeyId = req.KeyId
+	return &resp, nil
+}
 
-	toRet.Data = req.Data
+// DecodeHTTPListKeysResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded ListKeysResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPListKeysResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
 
-	encoder := json.NewEncoder(&buf)
-	encoder.SetEscapeHTML(false)
-	if err := encoder.Encode(toRet); err != nil {
-		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
 	}
-	r.Body = ioutil.NopCloser(&buf)
-	return nil
-}
 
-// EncodeHTTPEncryptBatchZeroRequest is a transport/http.EncodeRequestFunc
-// that encodes a encryptbatch request into the various portions of
-// the http request (path, query, and body).
-func EncodeHTTPEncryptBatchZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
-	strval := ""
-	_ = strval
-	req := request.(*pb.EncryptBatchRequest)
-	_ = req
+	var resp pb.ListKeysResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
 
-	r.Header.Set("transport", "HTTPJSON")
-	r.Header.Set("request-url", r.URL.Path)
+	return &resp, nil
+}
 
-	// Set the path parameters
-	path := strings.Join([]string{
-		"",
-		"encrypt_batch",
-	}, "/")
-	u, err := url.Parse(path)
+// DecodeHTTPDescribeKeyResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded KeyMetaResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPDescribeKeyResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
 	if err != nil {
-		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+		return nil, errors.Wrap(err, "cannot read http body")
 	}
-	r.URL.RawPath = u.RawPath
-	r.URL.Path = u.Path
 
-	// Set the query parameters
-	values := r.URL.Query()
-	var tmp []byte
-	_ = tmp
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
 
-	r.URL.RawQuery = values.Encode()
-	// Set the body parameters
-	var buf bytes.Buffer
-	toRet := request.(*pb.EncryptBatchRequest)
+	var resp pb.KeyMetaResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
 
-	toRet.Items = req.Items
+	return &resp, nil
+}
 
-	encoder := json.NewEncoder(&buf)
-	encoder.SetEscapeHTML(false)
-	if err := encoder.Encode(toRet); err != nil {
-		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+// DecodeHTTPDisableKeyResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded KeyMetaResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPDisableKeyResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
 	}
-	r.Body = ioutil.NopCloser(&buf)
-	return nil
-}
 
-// EncodeHTTPDecryptZeroRequest is a transport/http.EncodeRequestFunc
-// that encodes a decrypt request into the various portions of
-// the http request (path, query, and body).
-func EncodeHTTPDecryptZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
-	strval := ""
-	_ = strval
-	req := request.(*pb.DecryptRequest)
-	_ = req
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
 
-	r.Header.Set("transport", "HTTPJSON")
-	r.Header.Set("request-url", r.URL.Path)
+	var resp pb.KeyMetaResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
 
-	// Set the path parameters
-	path := strings.Join([]string{
-		"",
-		"decrypt",
-	}, "/")
-	u, err := url.Parse(path)
+	return &resp, nil
+}
+
+// DecodeHTTPEnableKeyResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded KeyMetaResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPEnableKeyResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
 	if err != nil {
-		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+		return nil, errors.Wrap(err, "cannot read http body")
 	}
-	r.URL.RawPath = u.RawPath
-	r.URL.Path = u.Path
 
-	// Set the query parameters
-	values := r.URL.Query()
-	var tmp []byte
-	_ = tmp
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
 
-	r.URL.RawQuery = values.Encode()
-	// Set the body parameters
-	var buf bytes.Buffer
-	toRet := request.(*pb.DecryptRequest)
+	var resp pb.KeyMetaResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
 
-	toRet.KeyId = req.KeyId
+	return &resp, nil
+}
+
+// DecodeHTTPScheduleKeyDeletionResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded KeyMetaResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPScheduleKeyDeletionResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
+
+	var resp pb.KeyMetaResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
+
+	return &resp, nil
+}
+
+// DecodeHTTPGenerateDataKeyResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded DataKeyResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPGenerateDataKeyResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
+
+	var resp pb.DataKeyResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
+
+	return &resp, nil
+}
+
+// DecodeHTTPUnwrapDataKeyResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded DataKeyResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPUnwrapDataKeyResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
+
+	var resp pb.DataKeyResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
+
+	return &resp, nil
+}
+
+// DecodeHTTPBlindIndexResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded BlindIndexResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPBlindIndexResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
+
+	var resp pb.BlindIndexResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
+
+	return &resp, nil
+}
+
+// DecodeHTTPBlindIndexBatchResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded BlindIndexBatchResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPBlindIndexBatchResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
+
+	var resp pb.BlindIndexBatchResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
+
+	return &resp, nil
+}
+
+// DecodeHTTPTokenizeResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded TokenizeResponse response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPTokenizeResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
+
+	var resp pb.TokenizeResponse
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
+
+	return &resp, nil
+}
+
+// DecodeHTTPDetokenizeResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded Response response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPDetokenizeResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
+
+	var resp pb.Response
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
+
+	return &resp, nil
+}
+
+// DecodeHTTPPingResponse is a transport/http.DecodeResponseFunc that decodes
+// a JSON-encoded Response response from the HTTP response body.
+// If the response has a non-200 status code, we will interpret that as an
+// error and attempt to decode the specific error message from the response
+// body. Primarily useful in a client.
+func DecodeHTTPPingResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err == io.EOF {
+		return nil, errors.New("response http body empty")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read http body")
+	}
+
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+	}
+
+	var resp pb.Response
+	if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
+		return nil, errorDecoder(buf)
+	}
+
+	return &resp, nil
+}
+
+// HTTP Client Encode
+
+// EncodeHTTPEncryptZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a encrypt request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPEncryptZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.EncryptRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"encrypt",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.EncryptRequest)
+
+	toRet.KeyId = req.KeyId
+
+	toRet.Data = req.Data
+
+	toRet.Context = req.Context
+
+	toRet.RawData = req.RawData
+
+	toRet.Deterministic = req.Deterministic
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPEncryptBatchZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a encryptbatch request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPEncryptBatchZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.EncryptBatchRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"encrypt_batch",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.EncryptBatchRequest)
+
+	toRet.Items = req.Items
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPDecryptZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a decrypt request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPDecryptZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.DecryptRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"decrypt",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.DecryptRequest)
+
+	toRet.KeyId = req.KeyId
+
+	toRet.Cipher = req.Cipher
+
+	toRet.Context = req.Context
+
+	toRet.RawCipher = req.RawCipher
+
+	toRet.Mask = req.Mask
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPDecryptBatchZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a decryptbatch request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPDecryptBatchZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.DecryptBatchRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"decrypt_batch",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.DecryptBatchRequest)
+
+	toRet.Items = req.Items
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPKeysZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a keys request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPKeysZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.KeyRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"keys",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.KeyRequest)
+
+	toRet.KeyIds = req.KeyIds
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPRotateZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a rotate request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPRotateZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.RotateRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"rotate",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.RotateRequest)
+
+	toRet.KeyId = req.KeyId
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPCreateKeyZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a createkey request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPCreateKeyZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.CreateKeyRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"key",
+		"create",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.CreateKeyRequest)
+
+	toRet.KeyId = req.KeyId
+
+	toRet.RotationPeriod = req.RotationPeriod
+
+	toRet.Mode = req.Mode
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPListKeysZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a listkeys request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPListKeysZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.ListKeysRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"key",
+		"list",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.ListKeysRequest)
+
+	toRet.PageToken = req.PageToken
+
+	toRet.PageSize = req.PageSize
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPDescribeKeyZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a describekey request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPDescribeKeyZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.KeyIdRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"key",
+		"describe",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.KeyIdRequest)
+
+	toRet.KeyId = req.KeyId
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPDisableKeyZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a disablekey request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPDisableKeyZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.KeyIdRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"key",
+		"disable",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.KeyIdRequest)
+
+	toRet.KeyId = req.KeyId
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
 
-	toRet.Cipher = req.Cipher
+// EncodeHTTPEnableKeyZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a enablekey request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPEnableKeyZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.KeyIdRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"key",
+		"enable",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.KeyIdRequest)
+
+	toRet.KeyId = req.KeyId
 
 	encoder := json.NewEncoder(&buf)
 	encoder.SetEscapeHTML(false)
@@ -447,13 +1324,13 @@ func EncodeHTTPDecryptZeroRequest(_ context.Context, r *http.Request, request in
 	return nil
 }
 
-// EncodeHTTPDecryptBatchZeroRequest is a transport/http.EncodeRequestFunc
-// that encodes a decryptbatch request into the various portions of
+// EncodeHTTPScheduleKeyDeletionZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a schedulekeydeletion request into the various portions of
 // the http request (path, query, and body).
-func EncodeHTTPDecryptBatchZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+func EncodeHTTPScheduleKeyDeletionZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
 	strval := ""
 	_ = strval
-	req := request.(*pb.DecryptBatchRequest)
+	req := request.(*pb.ScheduleKeyDeletionRequest)
 	_ = req
 
 	r.Header.Set("transport", "HTTPJSON")
@@ -462,7 +1339,8 @@ func EncodeHTTPDecryptBatchZeroRequest(_ context.Context, r *http.Request, reque
 	// Set the path parameters
 	path := strings.Join([]string{
 		"",
-		"decrypt_batch",
+		"key",
+		"schedule_deletion",
 	}, "/")
 	u, err := url.Parse(path)
 	if err != nil {
@@ -479,7 +1357,201 @@ func EncodeHTTPDecryptBatchZeroRequest(_ context.Context, r *http.Request, reque
 	r.URL.RawQuery = values.Encode()
 	// Set the body parameters
 	var buf bytes.Buffer
-	toRet := request.(*pb.DecryptBatchRequest)
+	toRet := request.(*pb.ScheduleKeyDeletionRequest)
+
+	toRet.KeyId = req.KeyId
+
+	toRet.PendingWindow = req.PendingWindow
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPGenerateDataKeyZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a generatedatakey request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPGenerateDataKeyZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.GenerateDataKeyRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"data_key",
+		"generate",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.GenerateDataKeyRequest)
+
+	toRet.KeyId = req.KeyId
+
+	toRet.Context = req.Context
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPUnwrapDataKeyZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a unwrapdatakey request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPUnwrapDataKeyZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.UnwrapDataKeyRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"data_key",
+		"unwrap",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.UnwrapDataKeyRequest)
+
+	toRet.KeyId = req.KeyId
+
+	toRet.Wrapped = req.Wrapped
+
+	toRet.Context = req.Context
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPBlindIndexZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a blindindex request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPBlindIndexZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.BlindIndexRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"blind_index",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.BlindIndexRequest)
+
+	toRet.KeyId = req.KeyId
+
+	toRet.Value = req.Value
+
+	toRet.AllVersions = req.AllVersions
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPBlindIndexBatchZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a blindindexbatch request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPBlindIndexBatchZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.BlindIndexBatchRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"blind_index_batch",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.BlindIndexBatchRequest)
 
 	toRet.Items = req.Items
 
@@ -492,13 +1564,13 @@ func EncodeHTTPDecryptBatchZeroRequest(_ context.Context, r *http.Request, reque
 	return nil
 }
 
-// EncodeHTTPKeysZeroRequest is a transport/http.EncodeRequestFunc
-// that encodes a keys request into the various portions of
+// EncodeHTTPTokenizeZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a tokenize request into the various portions of
 // the http request (path, query, and body).
-func EncodeHTTPKeysZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+func EncodeHTTPTokenizeZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
 	strval := ""
 	_ = strval
-	req := request.(*pb.KeyRequest)
+	req := request.(*pb.TokenizeRequest)
 	_ = req
 
 	r.Header.Set("transport", "HTTPJSON")
@@ -507,7 +1579,7 @@ func EncodeHTTPKeysZeroRequest(_ context.Context, r *http.Request, request inter
 	// Set the path parameters
 	path := strings.Join([]string{
 		"",
-		"keys",
+		"tokenize",
 	}, "/")
 	u, err := url.Parse(path)
 	if err != nil {
@@ -524,9 +1596,60 @@ func EncodeHTTPKeysZeroRequest(_ context.Context, r *http.Request, request inter
 	r.URL.RawQuery = values.Encode()
 	// Set the body parameters
 	var buf bytes.Buffer
-	toRet := request.(*pb.KeyRequest)
+	toRet := request.(*pb.TokenizeRequest)
 
-	toRet.KeyIds = req.KeyIds
+	toRet.KeyId = req.KeyId
+
+	toRet.Value = req.Value
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(toRet); err != nil {
+		return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+	}
+	r.Body = ioutil.NopCloser(&buf)
+	return nil
+}
+
+// EncodeHTTPDetokenizeZeroRequest is a transport/http.EncodeRequestFunc
+// that encodes a detokenize request into the various portions of
+// the http request (path, query, and body).
+func EncodeHTTPDetokenizeZeroRequest(_ context.Context, r *http.Request, request interface{}) error {
+	strval := ""
+	_ = strval
+	req := request.(*pb.DetokenizeRequest)
+	_ = req
+
+	r.Header.Set("transport", "HTTPJSON")
+	r.Header.Set("request-url", r.URL.Path)
+
+	// Set the path parameters
+	path := strings.Join([]string{
+		"",
+		"detokenize",
+	}, "/")
+	u, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	r.URL.RawPath = u.RawPath
+	r.URL.Path = u.Path
+
+	// Set the query parameters
+	values := r.URL.Query()
+	var tmp []byte
+	_ = tmp
+
+	r.URL.RawQuery = values.Encode()
+	// Set the body parameters
+	var buf bytes.Buffer
+	toRet := request.(*pb.DetokenizeRequest)
+
+	toRet.KeyId = req.KeyId
+
+	toRet.Token = req.Token
+
+	toRet.Version = req.Version
 
 	encoder := json.NewEncoder(&buf)
 	encoder.SetEscapeHTML(false)
@@ -0,0 +1,22 @@
+package http
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+)
+
+// WithTLS returns client option of TLS, use keyservice.NewClientTLSConfig for mTLS.
+// The instance passed to New must start with "https://", e.g.
+//
+//	cfg, err := keyservice.NewClientTLSConfig(keyservice.TLSOptions{CertFile: ..., KeyFile: ..., CAFile: ...})
+//	svc, err := client.New("https://keyservice:5050", client.WithTLS(cfg))
+func WithTLS(cfg *tls.Config) httptransport.ClientOption {
+	return httptransport.SetClient(&http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: cfg,
+		},
+	})
+}
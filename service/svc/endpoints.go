@@ -33,12 +33,25 @@ import (
 // single type that implements the Service interface. For example, you might
 // construct individual endpoints using transport/http.NewClient, combine them into an Endpoints, and return it to the caller as a Service.
 type Endpoints struct {
-	EncryptEndpoint      endpoint.Endpoint
-	EncryptBatchEndpoint endpoint.Endpoint
-	DecryptEndpoint      endpoint.Endpoint
-	DecryptBatchEndpoint endpoint.Endpoint
-	KeysEndpoint         endpoint.Endpoint
-	PingEndpoint         endpoint.Endpoint
+	EncryptEndpoint             endpoint.Endpoint
+	EncryptBatchEndpoint        endpoint.Endpoint
+	DecryptEndpoint             endpoint.Endpoint
+	DecryptBatchEndpoint        endpoint.Endpoint
+	KeysEndpoint                endpoint.Endpoint
+	RotateEndpoint              endpoint.Endpoint
+	CreateKeyEndpoint           endpoint.Endpoint
+	ListKeysEndpoint            endpoint.Endpoint
+	DescribeKeyEndpoint         endpoint.Endpoint
+	DisableKeyEndpoint          endpoint.Endpoint
+	EnableKeyEndpoint           endpoint.Endpoint
+	ScheduleKeyDeletionEndpoint endpoint.Endpoint
+	GenerateDataKeyEndpoint     endpoint.Endpoint
+	UnwrapDataKeyEndpoint       endpoint.Endpoint
+	BlindIndexEndpoint          endpoint.Endpoint
+	BlindIndexBatchEndpoint     endpoint.Endpoint
+	TokenizeEndpoint            endpoint.Endpoint
+	DetokenizeEndpoint          endpoint.Endpoint
+	PingEndpoint                endpoint.Endpoint
 }
 
 // Endpoints
@@ -83,6 +96,110 @@ func (e Endpoints) Keys(ctx context.Context, in *pb.KeyRequest) (*pb.KeyResponse
 	return response.(*pb.KeyResponse), nil
 }
 
+func (e Endpoints) Rotate(ctx context.Context, in *pb.RotateRequest) (*pb.Response, error) {
+	response, err := e.RotateEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.Response), nil
+}
+
+func (e Endpoints) CreateKey(ctx context.Context, in *pb.CreateKeyRequest) (*pb.KeyMetaResponse, error) {
+	response, err := e.CreateKeyEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.KeyMetaResponse), nil
+}
+
+func (e Endpoints) ListKeys(ctx context.Context, in *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
+	response, err := e.ListKeysEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.ListKeysResponse), nil
+}
+
+func (e Endpoints) DescribeKey(ctx context.Context, in *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	response, err := e.DescribeKeyEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.KeyMetaResponse), nil
+}
+
+func (e Endpoints) DisableKey(ctx context.Context, in *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	response, err := e.DisableKeyEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.KeyMetaResponse), nil
+}
+
+func (e Endpoints) EnableKey(ctx context.Context, in *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	response, err := e.EnableKeyEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.KeyMetaResponse), nil
+}
+
+func (e Endpoints) ScheduleKeyDeletion(ctx context.Context, in *pb.ScheduleKeyDeletionRequest) (*pb.KeyMetaResponse, error) {
+	response, err := e.ScheduleKeyDeletionEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.KeyMetaResponse), nil
+}
+
+func (e Endpoints) GenerateDataKey(ctx context.Context, in *pb.GenerateDataKeyRequest) (*pb.DataKeyResponse, error) {
+	response, err := e.GenerateDataKeyEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.DataKeyResponse), nil
+}
+
+func (e Endpoints) UnwrapDataKey(ctx context.Context, in *pb.UnwrapDataKeyRequest) (*pb.DataKeyResponse, error) {
+	response, err := e.UnwrapDataKeyEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.DataKeyResponse), nil
+}
+
+func (e Endpoints) BlindIndex(ctx context.Context, in *pb.BlindIndexRequest) (*pb.BlindIndexResponse, error) {
+	response, err := e.BlindIndexEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.BlindIndexResponse), nil
+}
+
+func (e Endpoints) BlindIndexBatch(ctx context.Context, in *pb.BlindIndexBatchRequest) (*pb.BlindIndexBatchResponse, error) {
+	response, err := e.BlindIndexBatchEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.BlindIndexBatchResponse), nil
+}
+
+func (e Endpoints) Tokenize(ctx context.Context, in *pb.TokenizeRequest) (*pb.TokenizeResponse, error) {
+	response, err := e.TokenizeEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.TokenizeResponse), nil
+}
+
+func (e Endpoints) Detokenize(ctx context.Context, in *pb.DetokenizeRequest) (*pb.Response, error) {
+	response, err := e.DetokenizeEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.Response), nil
+}
+
 func (e Endpoints) Ping(ctx context.Context, in *pb.Empty) (*pb.Response, error) {
 	response, err := e.PingEndpoint(ctx, in)
 	if err != nil {
@@ -148,6 +265,149 @@ func MakeKeysEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
 	}
 }
 
+func MakeRotateEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.RotateRequest)
+		v, err := s.Rotate(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeCreateKeyEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.CreateKeyRequest)
+		v, err := s.CreateKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeListKeysEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.ListKeysRequest)
+		v, err := s.ListKeys(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeDescribeKeyEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.KeyIdRequest)
+		v, err := s.DescribeKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeDisableKeyEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.KeyIdRequest)
+		v, err := s.DisableKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeEnableKeyEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.KeyIdRequest)
+		v, err := s.EnableKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeScheduleKeyDeletionEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.ScheduleKeyDeletionRequest)
+		v, err := s.ScheduleKeyDeletion(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeGenerateDataKeyEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.GenerateDataKeyRequest)
+		v, err := s.GenerateDataKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeUnwrapDataKeyEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.UnwrapDataKeyRequest)
+		v, err := s.UnwrapDataKey(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeBlindIndexEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.BlindIndexRequest)
+		v, err := s.BlindIndex(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeBlindIndexBatchEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.BlindIndexBatchRequest)
+		v, err := s.BlindIndexBatch(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeTokenizeEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.TokenizeRequest)
+		v, err := s.Tokenize(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeDetokenizeEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.DetokenizeRequest)
+		v, err := s.Detokenize(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
 func MakePingEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
 		req := request.(*pb.Empty)
@@ -166,12 +426,25 @@ func MakePingEndpoint(s pb.KeyServiceServer) endpoint.Endpoint {
 // WrapAllExcept(middleware, "Status", "Ping")
 func (e *Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...string) {
 	included := map[string]struct{}{
-		"Encrypt":      {},
-		"EncryptBatch": {},
-		"Decrypt":      {},
-		"DecryptBatch": {},
-		"Keys":         {},
-		"Ping":         {},
+		"Encrypt":             {},
+		"EncryptBatch":        {},
+		"Decrypt":             {},
+		"DecryptBatch":        {},
+		"Keys":                {},
+		"Rotate":              {},
+		"CreateKey":           {},
+		"ListKeys":            {},
+		"DescribeKey":         {},
+		"DisableKey":          {},
+		"EnableKey":           {},
+		"ScheduleKeyDeletion": {},
+		"GenerateDataKey":     {},
+		"UnwrapDataKey":       {},
+		"BlindIndex":          {},
+		"BlindIndexBatch":     {},
+		"Tokenize":            {},
+		"Detokenize":          {},
+		"Ping":                {},
 	}
 
 	for _, ex := range excluded {
@@ -197,6 +470,45 @@ func (e *Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...st
 		if inc == "Keys" {
 			e.KeysEndpoint = middleware(e.KeysEndpoint)
 		}
+		if inc == "Rotate" {
+			e.RotateEndpoint = middleware(e.RotateEndpoint)
+		}
+		if inc == "CreateKey" {
+			e.CreateKeyEndpoint = middleware(e.CreateKeyEndpoint)
+		}
+		if inc == "ListKeys" {
+			e.ListKeysEndpoint = middleware(e.ListKeysEndpoint)
+		}
+		if inc == "DescribeKey" {
+			e.DescribeKeyEndpoint = middleware(e.DescribeKeyEndpoint)
+		}
+		if inc == "DisableKey" {
+			e.DisableKeyEndpoint = middleware(e.DisableKeyEndpoint)
+		}
+		if inc == "EnableKey" {
+			e.EnableKeyEndpoint = middleware(e.EnableKeyEndpoint)
+		}
+		if inc == "ScheduleKeyDeletion" {
+			e.ScheduleKeyDeletionEndpoint = middleware(e.ScheduleKeyDeletionEndpoint)
+		}
+		if inc == "GenerateDataKey" {
+			e.GenerateDataKeyEndpoint = middleware(e.GenerateDataKeyEndpoint)
+		}
+		if inc == "UnwrapDataKey" {
+			e.UnwrapDataKeyEndpoint = middleware(e.UnwrapDataKeyEndpoint)
+		}
+		if inc == "BlindIndex" {
+			e.BlindIndexEndpoint = middleware(e.BlindIndexEndpoint)
+		}
+		if inc == "BlindIndexBatch" {
+			e.BlindIndexBatchEndpoint = middleware(e.BlindIndexBatchEndpoint)
+		}
+		if inc == "Tokenize" {
+			e.TokenizeEndpoint = middleware(e.TokenizeEndpoint)
+		}
+		if inc == "Detokenize" {
+			e.DetokenizeEndpoint = middleware(e.DetokenizeEndpoint)
+		}
 		if inc == "Ping" {
 			e.PingEndpoint = middleware(e.PingEndpoint)
 		}
@@ -214,12 +526,25 @@ type LabeledMiddleware func(string, endpoint.Endpoint) endpoint.Endpoint
 // functionality.
 func (e *Endpoints) WrapAllLabeledExcept(middleware func(string, endpoint.Endpoint) endpoint.Endpoint, excluded ...string) {
 	included := map[string]struct{}{
-		"Encrypt":      {},
-		"EncryptBatch": {},
-		"Decrypt":      {},
-		"DecryptBatch": {},
-		"Keys":         {},
-		"Ping":         {},
+		"Encrypt":             {},
+		"EncryptBatch":        {},
+		"Decrypt":             {},
+		"DecryptBatch":        {},
+		"Keys":                {},
+		"Rotate":              {},
+		"CreateKey":           {},
+		"ListKeys":            {},
+		"DescribeKey":         {},
+		"DisableKey":          {},
+		"EnableKey":           {},
+		"ScheduleKeyDeletion": {},
+		"GenerateDataKey":     {},
+		"UnwrapDataKey":       {},
+		"BlindIndex":          {},
+		"BlindIndexBatch":     {},
+		"Tokenize":            {},
+		"Detokenize":          {},
+		"Ping":                {},
 	}
 
 	for _, ex := range excluded {
@@ -245,6 +570,45 @@ func (e *Endpoints) WrapAllLabeledExcept(middleware func(string, endpoint.Endpoi
 		if inc == "Keys" {
 			e.KeysEndpoint = middleware("Keys", e.KeysEndpoint)
 		}
+		if inc == "Rotate" {
+			e.RotateEndpoint = middleware("Rotate", e.RotateEndpoint)
+		}
+		if inc == "CreateKey" {
+			e.CreateKeyEndpoint = middleware("CreateKey", e.CreateKeyEndpoint)
+		}
+		if inc == "ListKeys" {
+			e.ListKeysEndpoint = middleware("ListKeys", e.ListKeysEndpoint)
+		}
+		if inc == "DescribeKey" {
+			e.DescribeKeyEndpoint = middleware("DescribeKey", e.DescribeKeyEndpoint)
+		}
+		if inc == "DisableKey" {
+			e.DisableKeyEndpoint = middleware("DisableKey", e.DisableKeyEndpoint)
+		}
+		if inc == "EnableKey" {
+			e.EnableKeyEndpoint = middleware("EnableKey", e.EnableKeyEndpoint)
+		}
+		if inc == "ScheduleKeyDeletion" {
+			e.ScheduleKeyDeletionEndpoint = middleware("ScheduleKeyDeletion", e.ScheduleKeyDeletionEndpoint)
+		}
+		if inc == "GenerateDataKey" {
+			e.GenerateDataKeyEndpoint = middleware("GenerateDataKey", e.GenerateDataKeyEndpoint)
+		}
+		if inc == "UnwrapDataKey" {
+			e.UnwrapDataKeyEndpoint = middleware("UnwrapDataKey", e.UnwrapDataKeyEndpoint)
+		}
+		if inc == "BlindIndex" {
+			e.BlindIndexEndpoint = middleware("BlindIndex", e.BlindIndexEndpoint)
+		}
+		if inc == "BlindIndexBatch" {
+			e.BlindIndexBatchEndpoint = middleware("BlindIndexBatch", e.BlindIndexBatchEndpoint)
+		}
+		if inc == "Tokenize" {
+			e.TokenizeEndpoint = middleware("Tokenize", e.TokenizeEndpoint)
+		}
+		if inc == "Detokenize" {
+			e.DetokenizeEndpoint = middleware("Detokenize", e.DetokenizeEndpoint)
+		}
 		if inc == "Ping" {
 			e.PingEndpoint = middleware("Ping", e.PingEndpoint)
 		}
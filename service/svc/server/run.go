@@ -6,17 +6,26 @@
 package server
 
 import (
+	"context"
+	"crypto/tls"
+	"database/sql"
 	"log"
 	"net"
 	"net/http"
 	"net/http/pprof"
+	"strings"
 
 	// 3d Party
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+	httptransport "github.com/go-kit/kit/transport/http"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	// This Service
+	"github.com/techxmind/keyservice"
 	"github.com/techxmind/keyservice/config"
 	pb "github.com/techxmind/keyservice/interface-defs"
+	"github.com/techxmind/keyservice/metrics"
 	"github.com/techxmind/keyservice/service/handlers"
 	"github.com/techxmind/keyservice/service/svc"
 )
@@ -29,21 +38,47 @@ func NewEndpoints(service pb.KeyServiceServer) svc.Endpoints {
 
 	// Endpoint domain.
 	var (
-		encryptEndpoint      = svc.MakeEncryptEndpoint(service)
-		encryptbatchEndpoint = svc.MakeEncryptBatchEndpoint(service)
-		decryptEndpoint      = svc.MakeDecryptEndpoint(service)
-		decryptbatchEndpoint = svc.MakeDecryptBatchEndpoint(service)
-		keysEndpoint         = svc.MakeKeysEndpoint(service)
-		pingEndpoint         = svc.MakePingEndpoint(service)
+		encryptEndpoint             = svc.MakeEncryptEndpoint(service)
+		encryptbatchEndpoint        = svc.MakeEncryptBatchEndpoint(service)
+		decryptEndpoint             = svc.MakeDecryptEndpoint(service)
+		decryptbatchEndpoint        = svc.MakeDecryptBatchEndpoint(service)
+		keysEndpoint                = svc.MakeKeysEndpoint(service)
+		rotateEndpoint              = svc.MakeRotateEndpoint(service)
+		createkeyEndpoint           = svc.MakeCreateKeyEndpoint(service)
+		listkeysEndpoint            = svc.MakeListKeysEndpoint(service)
+		describekeyEndpoint         = svc.MakeDescribeKeyEndpoint(service)
+		disablekeyEndpoint          = svc.MakeDisableKeyEndpoint(service)
+		enablekeyEndpoint           = svc.MakeEnableKeyEndpoint(service)
+		schedulekeydeletionEndpoint = svc.MakeScheduleKeyDeletionEndpoint(service)
+		generatedatakeyEndpoint     = svc.MakeGenerateDataKeyEndpoint(service)
+		unwrapdatakeyEndpoint       = svc.MakeUnwrapDataKeyEndpoint(service)
+		blindindexEndpoint          = svc.MakeBlindIndexEndpoint(service)
+		blindindexbatchEndpoint     = svc.MakeBlindIndexBatchEndpoint(service)
+		tokenizeEndpoint            = svc.MakeTokenizeEndpoint(service)
+		detokenizeEndpoint          = svc.MakeDetokenizeEndpoint(service)
+		pingEndpoint                = svc.MakePingEndpoint(service)
 	)
 
 	endpoints := svc.Endpoints{
-		EncryptEndpoint:      encryptEndpoint,
-		EncryptBatchEndpoint: encryptbatchEndpoint,
-		DecryptEndpoint:      decryptEndpoint,
-		DecryptBatchEndpoint: decryptbatchEndpoint,
-		KeysEndpoint:         keysEndpoint,
-		PingEndpoint:         pingEndpoint,
+		EncryptEndpoint:             encryptEndpoint,
+		EncryptBatchEndpoint:        encryptbatchEndpoint,
+		DecryptEndpoint:             decryptEndpoint,
+		DecryptBatchEndpoint:        decryptbatchEndpoint,
+		KeysEndpoint:                keysEndpoint,
+		RotateEndpoint:              rotateEndpoint,
+		CreateKeyEndpoint:           createkeyEndpoint,
+		ListKeysEndpoint:            listkeysEndpoint,
+		DescribeKeyEndpoint:         describekeyEndpoint,
+		DisableKeyEndpoint:          disablekeyEndpoint,
+		EnableKeyEndpoint:           enablekeyEndpoint,
+		ScheduleKeyDeletionEndpoint: schedulekeydeletionEndpoint,
+		GenerateDataKeyEndpoint:     generatedatakeyEndpoint,
+		UnwrapDataKeyEndpoint:       unwrapdatakeyEndpoint,
+		BlindIndexEndpoint:          blindindexEndpoint,
+		BlindIndexBatchEndpoint:     blindindexbatchEndpoint,
+		TokenizeEndpoint:            tokenizeEndpoint,
+		DetokenizeEndpoint:          detokenizeEndpoint,
+		PingEndpoint:                pingEndpoint,
 	}
 
 	// Wrap selected Endpoints with middlewares. See handlers/middlewares.go
@@ -52,10 +87,115 @@ func NewEndpoints(service pb.KeyServiceServer) svc.Endpoints {
 	return endpoints
 }
 
+// newStorage returns storage by storage driver:
+// FileStorage if empty, BoltStorage if "bolt"(dsn is the database file), otherwise SQLStorage.
+func newStorage(cfg *config.Config) (keyservice.Storage, error) {
+	switch cfg.StorageDriver {
+	case "":
+		return keyservice.NewFileStorage(cfg.Keystore, cfg.Secret)
+	case "bolt":
+		return keyservice.NewBoltStorage(cfg.StorageDSN, cfg.Secret)
+	}
+
+	db, err := sql.Open(cfg.StorageDriver, cfg.StorageDSN)
+	if err != nil {
+		return nil, err
+	}
+	storage := keyservice.NewSQLStorage(db, cfg.StorageDriver, cfg.Secret)
+	if err = storage.CreateTables(); err != nil {
+		return nil, err
+	}
+
+	return storage, nil
+}
+
 // Run starts a new http server, gRPC server, and a debug server with the
 // passed config and logger
 func Run(cfg *config.Config) {
-	service := handlers.NewService()
+	if cfg.SeedKey == "" {
+		log.Fatalln("seed key required")
+	}
+	storage, err := newStorage(cfg)
+	if err != nil {
+		log.Fatalln("storage", "err", err)
+	}
+	ks := keyservice.NewKeyService(cfg.SeedKey, storage, keyservice.NewCache())
+	ks.SetMemoryCache(keyservice.MemoryCacheOptions{
+		Size:        cfg.CacheSize,
+		TTL:         cfg.CacheTTL,
+		NegativeTTL: cfg.CacheNegativeTTL,
+	})
+	ks.SetRefreshOptions(keyservice.RefreshOptions{
+		MaxStaleness: cfg.MaxStaleness,
+		RefreshAhead: cfg.RefreshAhead,
+	})
+	ks.SetResilienceOptions(keyservice.ResilienceOptions{
+		MaxKeyAge:        cfg.MaxKeyAge,
+		FailureThreshold: cfg.StorageFailureThreshold,
+		OpenTimeout:      cfg.StorageOpenTimeout,
+	})
+	metrics.RegisterCacheStats(ks.CacheStats)
+	metrics.RegisterHealth(ks.Health)
+
+	// Expire cached keys when they are changed in storage.
+	ks.WatchStorage(context.Background())
+
+	// Automatic key rotation and deletion.
+	if cfg.RotationInterval > 0 {
+		go ks.RunKeyScheduler(context.Background(), cfg.RotationInterval)
+	}
+
+	// Authorization.
+	if cfg.PolicyFile != "" {
+		policies, err := keyservice.LoadPolicies(cfg.PolicyFile)
+		if err != nil {
+			log.Fatalln("policy", "err", err)
+		}
+		handlers.SetPolicies(policies)
+	}
+	handlers.SetCallerHeader(cfg.CallerHeader)
+
+	// API token authentication.
+	if cfg.TokensFile != "" || cfg.TokenSecret != "" {
+		authenticator, err := keyservice.NewTokenAuthenticator(cfg.TokensFile, cfg.TokenSecret)
+		if err != nil {
+			log.Fatalln("tokens", "err", err)
+		}
+		handlers.SetTokenAuthenticator(authenticator)
+	}
+
+	// Audit log.
+	if cfg.AuditSinks != "" {
+		var sinks []keyservice.AuditSink
+		// Audit files are tamper-evident, verify them with `keyservice audit verify`.
+		chainKey := keyservice.AuditChainKey(cfg.SeedKey)
+		for _, spec := range strings.Split(cfg.AuditSinks, ",") {
+			sink, err := keyservice.OpenAuditSink(strings.TrimSpace(spec), cfg.AuditMaxSize, cfg.AuditMaxBackups, chainKey)
+			if err != nil {
+				log.Fatalln("audit", "err", err)
+			}
+			sinks = append(sinks, sink)
+		}
+		auditor := keyservice.NewAuditor(sinks...)
+		defer auditor.Close()
+		handlers.SetAuditor(auditor, ks)
+	}
+
+	// TLS on HTTP and gRPC listeners, certificates are reloaded on change.
+	var tlsConfig *tls.Config
+	if cfg.TLSCertFile != "" {
+		tlsConfig, err = keyservice.NewServerTLSConfig(keyservice.TLSOptions{
+			CertFile:          cfg.TLSCertFile,
+			KeyFile:           cfg.TLSKeyFile,
+			CAFile:            cfg.TLSClientCAFile,
+			RequireClientCert: cfg.TLSRequireClientCert,
+		})
+		if err != nil {
+			log.Fatalln("tls", "err", err)
+		}
+	}
+
+	service := handlers.NewService(ks)
 	endpoints := NewEndpoints(service)
 
 	// Mechanical domain.
@@ -76,6 +216,7 @@ func Run(cfg *config.Config) {
 		m.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
 
 		handlers.WrapDebugService(m)
+		m.Handle("/health", handlers.HealthHandler(ks))
 
 		errc <- http.ListenAndServe(cfg.DebugAddr, m)
 	}()
@@ -83,7 +224,12 @@ func Run(cfg *config.Config) {
 	// HTTP transport.
 	go func() {
 		log.Println("transport", "HTTP", "addr", cfg.HTTPAddr)
-		h := svc.MakeHTTPHandler(endpoints)
+		h := svc.MakeHTTPHandler(endpoints, httptransport.ServerBefore(handlers.HTTPCallerToContext, handlers.HTTPRequestIDToContext))
+		if tlsConfig != nil {
+			srv := &http.Server{Addr: cfg.HTTPAddr, Handler: h, TLSConfig: tlsConfig}
+			errc <- srv.ListenAndServeTLS("", "")
+			return
+		}
 		errc <- http.ListenAndServe(cfg.HTTPAddr, h)
 	}()
 
@@ -96,9 +242,15 @@ func Run(cfg *config.Config) {
 			return
 		}
 
-		srv := svc.MakeGRPCServer(endpoints)
-		s := grpc.NewServer()
+		srv := svc.MakeGRPCServer(endpoints, grpctransport.ServerBefore(handlers.GRPCCallerToContext, handlers.GRPCRequestIDToContext))
+		var opts []grpc.ServerOption
+		if tlsConfig != nil {
+			opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		}
+		s := grpc.NewServer(opts...)
 		pb.RegisterKeyServiceServer(s, srv)
+		// truss doesn't generate streaming RPCs, register them directly.
+		pb.RegisterKeyStreamServiceServer(s, handlers.NewStreamService(ks))
 
 		errc <- s.Serve(ln)
 	}()
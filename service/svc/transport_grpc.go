@@ -59,6 +59,84 @@ func MakeGRPCServer(endpoints Endpoints, options ...grpctransport.ServerOption)
 			EncodeGRPCKeysResponse,
 			serverOptions...,
 		),
+		rotate: grpctransport.NewServer(
+			endpoints.RotateEndpoint,
+			DecodeGRPCRotateRequest,
+			EncodeGRPCRotateResponse,
+			serverOptions...,
+		),
+		createkey: grpctransport.NewServer(
+			endpoints.CreateKeyEndpoint,
+			DecodeGRPCCreateKeyRequest,
+			EncodeGRPCCreateKeyResponse,
+			serverOptions...,
+		),
+		listkeys: grpctransport.NewServer(
+			endpoints.ListKeysEndpoint,
+			DecodeGRPCListKeysRequest,
+			EncodeGRPCListKeysResponse,
+			serverOptions...,
+		),
+		describekey: grpctransport.NewServer(
+			endpoints.DescribeKeyEndpoint,
+			DecodeGRPCDescribeKeyRequest,
+			EncodeGRPCDescribeKeyResponse,
+			serverOptions...,
+		),
+		disablekey: grpctransport.NewServer(
+			endpoints.DisableKeyEndpoint,
+			DecodeGRPCDisableKeyRequest,
+			EncodeGRPCDisableKeyResponse,
+			serverOptions...,
+		),
+		enablekey: grpctransport.NewServer(
+			endpoints.EnableKeyEndpoint,
+			DecodeGRPCEnableKeyRequest,
+			EncodeGRPCEnableKeyResponse,
+			serverOptions...,
+		),
+		schedulekeydeletion: grpctransport.NewServer(
+			endpoints.ScheduleKeyDeletionEndpoint,
+			DecodeGRPCScheduleKeyDeletionRequest,
+			EncodeGRPCScheduleKeyDeletionResponse,
+			serverOptions...,
+		),
+		generatedatakey: grpctransport.NewServer(
+			endpoints.GenerateDataKeyEndpoint,
+			DecodeGRPCGenerateDataKeyRequest,
+			EncodeGRPCGenerateDataKeyResponse,
+			serverOptions...,
+		),
+		unwrapdatakey: grpctransport.NewServer(
+			endpoints.UnwrapDataKeyEndpoint,
+			DecodeGRPCUnwrapDataKeyRequest,
+			EncodeGRPCUnwrapDataKeyResponse,
+			serverOptions...,
+		),
+		blindindex: grpctransport.NewServer(
+			endpoints.BlindIndexEndpoint,
+			DecodeGRPCBlindIndexRequest,
+			EncodeGRPCBlindIndexResponse,
+			serverOptions...,
+		),
+		blindindexbatch: grpctransport.NewServer(
+			endpoints.BlindIndexBatchEndpoint,
+			DecodeGRPCBlindIndexBatchRequest,
+			EncodeGRPCBlindIndexBatchResponse,
+			serverOptions...,
+		),
+		tokenize: grpctransport.NewServer(
+			endpoints.TokenizeEndpoint,
+			DecodeGRPCTokenizeRequest,
+			EncodeGRPCTokenizeResponse,
+			serverOptions...,
+		),
+		detokenize: grpctransport.NewServer(
+			endpoints.DetokenizeEndpoint,
+			DecodeGRPCDetokenizeRequest,
+			EncodeGRPCDetokenizeResponse,
+			serverOptions...,
+		),
 		ping: grpctransport.NewServer(
 			endpoints.PingEndpoint,
 			DecodeGRPCPingRequest,
@@ -70,12 +148,25 @@ func MakeGRPCServer(endpoints Endpoints, options ...grpctransport.ServerOption)
 
 // grpcServer implements the KeyServiceServer interface
 type grpcServer struct {
-	encrypt      grpctransport.Handler
-	encryptbatch grpctransport.Handler
-	decrypt      grpctransport.Handler
-	decryptbatch grpctransport.Handler
-	keys         grpctransport.Handler
-	ping         grpctransport.Handler
+	encrypt             grpctransport.Handler
+	encryptbatch        grpctransport.Handler
+	decrypt             grpctransport.Handler
+	decryptbatch        grpctransport.Handler
+	keys                grpctransport.Handler
+	rotate              grpctransport.Handler
+	createkey           grpctransport.Handler
+	listkeys            grpctransport.Handler
+	describekey         grpctransport.Handler
+	disablekey          grpctransport.Handler
+	enablekey           grpctransport.Handler
+	schedulekeydeletion grpctransport.Handler
+	generatedatakey     grpctransport.Handler
+	unwrapdatakey       grpctransport.Handler
+	blindindex          grpctransport.Handler
+	blindindexbatch     grpctransport.Handler
+	tokenize            grpctransport.Handler
+	detokenize          grpctransport.Handler
+	ping                grpctransport.Handler
 }
 
 // Methods for grpcServer to implement KeyServiceServer interface
@@ -120,6 +211,110 @@ func (s *grpcServer) Keys(ctx context.Context, req *pb.KeyRequest) (*pb.KeyRespo
 	return rep.(*pb.KeyResponse), nil
 }
 
+func (s *grpcServer) Rotate(ctx context.Context, req *pb.RotateRequest) (*pb.Response, error) {
+	_, rep, err := s.rotate.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Response), nil
+}
+
+func (s *grpcServer) CreateKey(ctx context.Context, req *pb.CreateKeyRequest) (*pb.KeyMetaResponse, error) {
+	_, rep, err := s.createkey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.KeyMetaResponse), nil
+}
+
+func (s *grpcServer) ListKeys(ctx context.Context, req *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
+	_, rep, err := s.listkeys.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ListKeysResponse), nil
+}
+
+func (s *grpcServer) DescribeKey(ctx context.Context, req *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	_, rep, err := s.describekey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.KeyMetaResponse), nil
+}
+
+func (s *grpcServer) DisableKey(ctx context.Context, req *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	_, rep, err := s.disablekey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.KeyMetaResponse), nil
+}
+
+func (s *grpcServer) EnableKey(ctx context.Context, req *pb.KeyIdRequest) (*pb.KeyMetaResponse, error) {
+	_, rep, err := s.enablekey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.KeyMetaResponse), nil
+}
+
+func (s *grpcServer) ScheduleKeyDeletion(ctx context.Context, req *pb.ScheduleKeyDeletionRequest) (*pb.KeyMetaResponse, error) {
+	_, rep, err := s.schedulekeydeletion.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.KeyMetaResponse), nil
+}
+
+func (s *grpcServer) GenerateDataKey(ctx context.Context, req *pb.GenerateDataKeyRequest) (*pb.DataKeyResponse, error) {
+	_, rep, err := s.generatedatakey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.DataKeyResponse), nil
+}
+
+func (s *grpcServer) UnwrapDataKey(ctx context.Context, req *pb.UnwrapDataKeyRequest) (*pb.DataKeyResponse, error) {
+	_, rep, err := s.unwrapdatakey.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.DataKeyResponse), nil
+}
+
+func (s *grpcServer) BlindIndex(ctx context.Context, req *pb.BlindIndexRequest) (*pb.BlindIndexResponse, error) {
+	_, rep, err := s.blindindex.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.BlindIndexResponse), nil
+}
+
+func (s *grpcServer) BlindIndexBatch(ctx context.Context, req *pb.BlindIndexBatchRequest) (*pb.BlindIndexBatchResponse, error) {
+	_, rep, err := s.blindindexbatch.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.BlindIndexBatchResponse), nil
+}
+
+func (s *grpcServer) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb.TokenizeResponse, error) {
+	_, rep, err := s.tokenize.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.TokenizeResponse), nil
+}
+
+func (s *grpcServer) Detokenize(ctx context.Context, req *pb.DetokenizeRequest) (*pb.Response, error) {
+	_, rep, err := s.detokenize.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Response), nil
+}
+
 func (s *grpcServer) Ping(ctx context.Context, req *pb.Empty) (*pb.Response, error) {
 	_, rep, err := s.ping.ServeGRPC(ctx, req)
 	if err != nil {
@@ -165,6 +360,97 @@ func DecodeGRPCKeysRequest(_ context.Context, grpcReq interface{}) (interface{},
 	return req, nil
 }
 
+// DecodeGRPCRotateRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC rotate request to a user-domain rotate request. Primarily useful in a server.
+func DecodeGRPCRotateRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.RotateRequest)
+	return req, nil
+}
+
+// DecodeGRPCCreateKeyRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC createkey request to a user-domain createkey request. Primarily useful in a server.
+func DecodeGRPCCreateKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreateKeyRequest)
+	return req, nil
+}
+
+// DecodeGRPCListKeysRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC listkeys request to a user-domain listkeys request. Primarily useful in a server.
+func DecodeGRPCListKeysRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListKeysRequest)
+	return req, nil
+}
+
+// DecodeGRPCDescribeKeyRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC describekey request to a user-domain describekey request. Primarily useful in a server.
+func DecodeGRPCDescribeKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.KeyIdRequest)
+	return req, nil
+}
+
+// DecodeGRPCDisableKeyRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC disablekey request to a user-domain disablekey request. Primarily useful in a server.
+func DecodeGRPCDisableKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.KeyIdRequest)
+	return req, nil
+}
+
+// DecodeGRPCEnableKeyRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC enablekey request to a user-domain enablekey request. Primarily useful in a server.
+func DecodeGRPCEnableKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.KeyIdRequest)
+	return req, nil
+}
+
+// DecodeGRPCScheduleKeyDeletionRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC schedulekeydeletion request to a user-domain schedulekeydeletion request. Primarily useful in a server.
+func DecodeGRPCScheduleKeyDeletionRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ScheduleKeyDeletionRequest)
+	return req, nil
+}
+
+// DecodeGRPCGenerateDataKeyRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC generatedatakey request to a user-domain generatedatakey request. Primarily useful in a server.
+func DecodeGRPCGenerateDataKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.GenerateDataKeyRequest)
+	return req, nil
+}
+
+// DecodeGRPCUnwrapDataKeyRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC unwrapdatakey request to a user-domain unwrapdatakey request. Primarily useful in a server.
+func DecodeGRPCUnwrapDataKeyRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UnwrapDataKeyRequest)
+	return req, nil
+}
+
+// DecodeGRPCBlindIndexRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC blindindex request to a user-domain blindindex request. Primarily useful in a server.
+func DecodeGRPCBlindIndexRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.BlindIndexRequest)
+	return req, nil
+}
+
+// DecodeGRPCBlindIndexBatchRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC blindindexbatch request to a user-domain blindindexbatch request. Primarily useful in a server.
+func DecodeGRPCBlindIndexBatchRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.BlindIndexBatchRequest)
+	return req, nil
+}
+
+// DecodeGRPCTokenizeRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC tokenize request to a user-domain tokenize request. Primarily useful in a server.
+func DecodeGRPCTokenizeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.TokenizeRequest)
+	return req, nil
+}
+
+// DecodeGRPCDetokenizeRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC detokenize request to a user-domain detokenize request. Primarily useful in a server.
+func DecodeGRPCDetokenizeRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.DetokenizeRequest)
+	return req, nil
+}
+
 // DecodeGRPCPingRequest is a transport/grpc.DecodeRequestFunc that converts a
 // gRPC ping request to a user-domain ping request. Primarily useful in a server.
 func DecodeGRPCPingRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
@@ -209,6 +495,97 @@ func EncodeGRPCKeysResponse(_ context.Context, response interface{}) (interface{
 	return resp, nil
 }
 
+// EncodeGRPCRotateResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain rotate response to a gRPC rotate reply. Primarily useful in a server.
+func EncodeGRPCRotateResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.Response)
+	return resp, nil
+}
+
+// EncodeGRPCCreateKeyResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain createkey response to a gRPC createkey reply. Primarily useful in a server.
+func EncodeGRPCCreateKeyResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.KeyMetaResponse)
+	return resp, nil
+}
+
+// EncodeGRPCListKeysResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain listkeys response to a gRPC listkeys reply. Primarily useful in a server.
+func EncodeGRPCListKeysResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.ListKeysResponse)
+	return resp, nil
+}
+
+// EncodeGRPCDescribeKeyResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain describekey response to a gRPC describekey reply. Primarily useful in a server.
+func EncodeGRPCDescribeKeyResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.KeyMetaResponse)
+	return resp, nil
+}
+
+// EncodeGRPCDisableKeyResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain disablekey response to a gRPC disablekey reply. Primarily useful in a server.
+func EncodeGRPCDisableKeyResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.KeyMetaResponse)
+	return resp, nil
+}
+
+// EncodeGRPCEnableKeyResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain enablekey response to a gRPC enablekey reply. Primarily useful in a server.
+func EncodeGRPCEnableKeyResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.KeyMetaResponse)
+	return resp, nil
+}
+
+// EncodeGRPCScheduleKeyDeletionResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain schedulekeydeletion response to a gRPC schedulekeydeletion reply. Primarily useful in a server.
+func EncodeGRPCScheduleKeyDeletionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.KeyMetaResponse)
+	return resp, nil
+}
+
+// EncodeGRPCGenerateDataKeyResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain generatedatakey response to a gRPC generatedatakey reply. Primarily useful in a server.
+func EncodeGRPCGenerateDataKeyResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.DataKeyResponse)
+	return resp, nil
+}
+
+// EncodeGRPCUnwrapDataKeyResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain unwrapdatakey response to a gRPC unwrapdatakey reply. Primarily useful in a server.
+func EncodeGRPCUnwrapDataKeyResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.DataKeyResponse)
+	return resp, nil
+}
+
+// EncodeGRPCBlindIndexResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain blindindex response to a gRPC blindindex reply. Primarily useful in a server.
+func EncodeGRPCBlindIndexResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.BlindIndexResponse)
+	return resp, nil
+}
+
+// EncodeGRPCBlindIndexBatchResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain blindindexbatch response to a gRPC blindindexbatch reply. Primarily useful in a server.
+func EncodeGRPCBlindIndexBatchResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.BlindIndexBatchResponse)
+	return resp, nil
+}
+
+// EncodeGRPCTokenizeResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain tokenize response to a gRPC tokenize reply. Primarily useful in a server.
+func EncodeGRPCTokenizeResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.TokenizeResponse)
+	return resp, nil
+}
+
+// EncodeGRPCDetokenizeResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain detokenize response to a gRPC detokenize reply. Primarily useful in a server.
+func EncodeGRPCDetokenizeResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.Response)
+	return resp, nil
+}
+
 // EncodeGRPCPingResponse is a transport/grpc.EncodeResponseFunc that converts a
 // user-domain ping response to a gRPC ping reply. Primarily useful in a server.
 func EncodeGRPCPingResponse(_ context.Context, response interface{}) (interface{}, error) {
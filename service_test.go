@@ -1,6 +1,8 @@
 package keyservice
 
 import (
+	"crypto/md5"
+	"encoding/base64"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -24,6 +26,8 @@ func TestService(t *testing.T) {
 		s,
 		c,
 	)
+	// refresh expired keys synchronously
+	sv.SetRefreshOptions(RefreshOptions{})
 
 	keyNotExistsId := "key-not-exists-id"
 	keys, err := sv.GetKeys([]string{_testKeyId1, _testKeyId2, keyNotExistsId})
@@ -59,10 +63,234 @@ func TestService(t *testing.T) {
 	assert.Equal(t, str, decrypted)
 
 	decrypted, err = sv.Decrypt(encrypted+"?", _testKeyId1)
-	assert.NotNil(t, err)
+	assert.Equal(t, ErrInvalidEncryptedData, err)
 	t.Log(err)
 
 	decrypted, err = sv.Decrypt(encrypted, _testKeyId2)
 	assert.NotNil(t, err)
 	t.Logf("decrypted:%s, err:%v", decrypted, err)
 }
+
+// encryptLegacy generates data in legacy format: sig(4 bytes)+version(2 bytes)+AES-128-CBC data
+func encryptLegacy(sv *KeyService, content string, key *Key) string {
+	cipherBytes, _ := newCipher(key.Current().Value).Encrypt([]byte(content))
+	sl, vl, cl := sv.signatureSize, sv.versionSize, len(cipherBytes)
+	bs := make([]byte, sl+vl+cl+len(sv.seedKey))
+	copy(bs[sl+vl:], cipherBytes)
+	copy(bs[sl+vl+cl:], sv.seedKey)
+	bs[sl] = byte(key.Version >> 8)
+	bs[sl+1] = byte(key.Version & 0xff)
+	sig := md5.Sum(bs[sl:])
+	copy(bs[0:sl], shortSignature(sig, sl))
+	return base64.RawURLEncoding.EncodeToString(bs[0 : sl+vl+cl])
+}
+
+func TestServiceEnvelope(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	str := "hello,world!"
+	encrypted, err := sv.Encrypt(str, _testKeyId1)
+	assert.Nil(t, err)
+	data, err := base64.RawURLEncoding.DecodeString(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, formatAESGCM, data[0])
+
+	// tampered data
+	for _, i := range []int{1, envelopeHeaderSize, len(data) - 1} {
+		bs := append([]byte{}, data...)
+		bs[i] ^= 0x01
+		_, err = sv.Decrypt(base64.RawURLEncoding.EncodeToString(bs), _testKeyId1)
+		assert.NotNil(t, err)
+	}
+
+	// different seed key
+	_, err = NewKeyService("seed-key-2", s, newTestCache()).Decrypt(encrypted, _testKeyId1)
+	assert.NotNil(t, err)
+
+	// legacy format
+	for i := 0; i < 100; i++ {
+		legacy := encryptLegacy(sv, str, _testKey1)
+		decrypted, err := sv.Decrypt(legacy, _testKeyId1)
+		assert.Nil(t, err)
+		assert.Equal(t, str, decrypted)
+	}
+
+	// legacy format encrypted by previous version
+	legacy := encryptLegacy(sv, str, &Key{Version: 1, Versions: _testKey2.Versions[:1]})
+	decrypted, err := sv.Decrypt(legacy, _testKeyId2)
+	assert.Nil(t, err)
+	assert.Equal(t, str, decrypted)
+}
+
+func TestServiceEncryptWithContext(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	str := "13800138000"
+	context := map[string]string{"tenant": "t1", "column": "user.phone"}
+	encrypted, err := sv.EncryptWithContext(str, _testKeyId1, context)
+	assert.Nil(t, err)
+
+	decrypted, err := sv.DecryptWithContext(encrypted, _testKeyId1, map[string]string{"column": "user.phone", "tenant": "t1"})
+	assert.Nil(t, err)
+	assert.Equal(t, str, decrypted)
+
+	for _, ctx := range []map[string]string{
+		nil,
+		{"tenant": "t2", "column": "user.phone"},
+		{"tenant": "t1", "column": "user.mobile"},
+		{"tenant": "t1"},
+		{"tenant": "t1", "column": "user.phone", "x": ""},
+		// key/value boundaries must be unambiguous
+		{"tenant": "t1c", "olumn": "user.phone"},
+	} {
+		_, err = sv.DecryptWithContext(encrypted, _testKeyId1, ctx)
+		assert.NotNil(t, err, "context %v", ctx)
+	}
+
+	// data encrypted without context
+	encrypted, err = sv.Encrypt(str, _testKeyId1)
+	assert.Nil(t, err)
+	_, err = sv.DecryptWithContext(encrypted, _testKeyId1, context)
+	assert.NotNil(t, err)
+
+	// legacy format can't be bound to context
+	_, err = sv.DecryptWithContext(encryptLegacy(sv, str, _testKey1), _testKeyId1, context)
+	assert.Equal(t, ErrInvalidEncryptedData, err)
+}
+
+func TestServiceKeyVersions(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	str := "hello,world!"
+	keyID := "test-key-versions"
+	key := NewKey("value-1")
+	s.Store(keyID, key)
+
+	encrypted := make([]string, 0)
+	for i := 2; i <= 4; i++ {
+		e, err := sv.Encrypt(str, keyID)
+		assert.Nil(t, err)
+		encrypted = append(encrypted, e)
+
+		// rotate
+		key = key.Copy()
+		key.Current().State = KeyStateDecryptOnly
+		key.Version = uint16(i)
+		key.Versions = append(key.Versions, &KeyVersion{Version: uint16(i), Value: fmt.Sprintf("value-%d", i), State: KeyStateActive})
+		s.Store(keyID, key)
+		sv.cache.Store(keyID, key)
+	}
+
+	// all versions are decryptable
+	for _, e := range encrypted {
+		decrypted, err := sv.Decrypt(e, keyID)
+		assert.Nil(t, err)
+		assert.Equal(t, str, decrypted)
+	}
+
+	// disabled/destroyed versions
+	key = key.Copy()
+	key.Get(1).State = KeyStateDisabled
+	key.Get(2).State = KeyStateDestroyed
+	key.Get(2).Value = ""
+	sv.cache.Store(keyID, key)
+	for i, e := range encrypted {
+		decrypted, err := sv.Decrypt(e, keyID)
+		if i < 2 {
+			assert.Equal(t, ErrInvalidEncryptedData, err)
+		} else {
+			assert.Equal(t, str, decrypted)
+		}
+	}
+
+	// disabled current version
+	key = key.Copy()
+	key.Current().State = KeyStateDisabled
+	sv.cache.Store(keyID, key)
+	_, err := sv.Encrypt(str, keyID)
+	assert.Equal(t, ErrKeyDisabled, err)
+}
+
+func TestServiceEncryptBytes(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	content := []byte{0x00, 0x01, 0xff, 0xfe, 'a'}
+	encrypted, err := sv.EncryptBytes(content, _testKeyId2)
+	assert.NoError(t, err)
+	assert.Equal(t, formatAESGCM, encrypted[0])
+
+	decrypted, err := sv.DecryptBytes(encrypted, _testKeyId2)
+	assert.NoError(t, err)
+	assert.Equal(t, content, decrypted)
+
+	// compatible with the string API
+	str, err := sv.Decrypt(base64.RawURLEncoding.EncodeToString(encrypted), _testKeyId2)
+	assert.NoError(t, err)
+	assert.Equal(t, string(content), str)
+
+	context := map[string]string{"column": "user.avatar"}
+	encrypted, err = sv.EncryptBytesWithContext(content, _testKeyId2, context)
+	assert.NoError(t, err)
+	decrypted, err = sv.DecryptBytesWithContext(encrypted, _testKeyId2, context)
+	assert.NoError(t, err)
+	assert.Equal(t, content, decrypted)
+	_, err = sv.DecryptBytes(encrypted, _testKeyId2)
+	assert.Error(t, err)
+
+	_, err = sv.DecryptBytes(nil, _testKeyId2)
+	assert.Equal(t, ErrInvalidEncryptedData, err)
+	_, err = sv.EncryptBytes(content, "key-not-exists-id")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestServiceEncryptDeterministic(t *testing.T) {
+	s := newTestStorage()
+	sv := NewKeyService("seed-key", s, newTestCache())
+
+	str := "13800138000"
+	context := map[string]string{"column": "user.phone"}
+	encrypted, err := sv.EncryptDeterministic(str, _testKeyId2, context)
+	assert.NoError(t, err)
+	encrypted2, err := sv.EncryptDeterministic(str, _testKeyId2, context)
+	assert.NoError(t, err)
+	assert.Equal(t, encrypted, encrypted2)
+
+	// differs with other content, context or key
+	encrypted2, _ = sv.EncryptDeterministic("13800138001", _testKeyId2, context)
+	assert.NotEqual(t, encrypted, encrypted2)
+	encrypted2, _ = sv.EncryptDeterministic(str, _testKeyId2, map[string]string{"column": "user.mobile"})
+	assert.NotEqual(t, encrypted, encrypted2)
+	encrypted2, _ = sv.EncryptDeterministic(str, _testKeyId1, context)
+	assert.NotEqual(t, encrypted, encrypted2)
+	// randomized
+	encrypted2, _ = sv.EncryptWithContext(str, _testKeyId2, context)
+	assert.NotEqual(t, encrypted, encrypted2)
+
+	decrypted, err := sv.DecryptWithContext(encrypted, _testKeyId2, context)
+	assert.NoError(t, err)
+	assert.Equal(t, str, decrypted)
+	_, err = sv.DecryptWithContext(encrypted, _testKeyId2, nil)
+	assert.Error(t, err)
+
+	bs, err := base64.RawURLEncoding.DecodeString(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, formatDeterministic, bs[0])
+	bs[len(bs)-1] ^= 1
+	_, err = sv.DecryptBytesWithContext(bs, _testKeyId2, context)
+	assert.Equal(t, ErrSignatureError, err)
+
+	// key in deterministic mode
+	key := _testKey1.Copy()
+	key.Mode = EncryptionModeDeterministic
+	s.Store("key-deterministic", key)
+	encrypted, err = sv.Encrypt(str, "key-deterministic")
+	assert.NoError(t, err)
+	encrypted2, err = sv.Encrypt(str, "key-deterministic")
+	assert.NoError(t, err)
+	assert.Equal(t, encrypted, encrypted2)
+	decrypted, err = sv.Decrypt(encrypted, "key-deterministic")
+	assert.NoError(t, err)
+	assert.Equal(t, str, decrypted)
+}
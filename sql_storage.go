@@ -0,0 +1,449 @@
+package keyservice
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SQL schema of SQLStorage.
+// Key values are encrypted with secret the same way FileStorage encrypts keystore file.
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS keyservice_keys (
+		id              VARCHAR(128) NOT NULL PRIMARY KEY,
+		version         INTEGER NOT NULL,
+		rotation_period BIGINT NOT NULL DEFAULT 0,
+		state           VARCHAR(16) NOT NULL DEFAULT '',
+		deletion_time   BIGINT NOT NULL DEFAULT 0,
+		previous_state  VARCHAR(16) NOT NULL DEFAULT '',
+		mode            VARCHAR(16) NOT NULL DEFAULT '',
+		revision        BIGINT NOT NULL DEFAULT 0,
+		updated_at      BIGINT NOT NULL DEFAULT 0
+	)`,
+	`CREATE TABLE IF NOT EXISTS keyservice_key_versions (
+		key_id     VARCHAR(128) NOT NULL,
+		version    INTEGER NOT NULL,
+		state      VARCHAR(16) NOT NULL,
+		value      TEXT NOT NULL,
+		created_at BIGINT NOT NULL DEFAULT 0,
+		updated_at BIGINT NOT NULL DEFAULT 0,
+		PRIMARY KEY (key_id, version)
+	)`,
+}
+
+// sqlColumns are columns added after tables were created, CreateTables adds them if missing
+var sqlColumns = []struct {
+	table, column, definition string
+}{
+	{"keyservice_keys", "revision", "BIGINT NOT NULL DEFAULT 0"},
+	{"keyservice_keys", "previous_state", "VARCHAR(16) NOT NULL DEFAULT ''"},
+}
+
+// SQLStorage stores keys in SQL database, so that all service instances can share keys.
+type SQLStorage struct {
+	db     *sql.DB
+	secret string
+	// placeholder returns the i-th(1-based) bind variable
+	placeholder func(i int) string
+}
+
+// NewSQLStorage returns storage based on db, driverName is used to determine bind variable
+// style: $1 for postgres, ? for others(mysql, sqlite3).
+func NewSQLStorage(db *sql.DB, driverName string, secret string) *SQLStorage {
+	s := &SQLStorage{
+		db:          db,
+		secret:      secret,
+		placeholder: func(int) string { return "?" },
+	}
+	switch driverName {
+	case "postgres", "pgx":
+		s.placeholder = func(i int) string { return fmt.Sprintf("$%d", i) }
+	}
+	return s
+}
+
+// CreateTables creates tables if not exist, and adds missing columns to existing tables
+func (s *SQLStorage) CreateTables() error {
+	for _, stmt := range sqlSchema {
+		if _, err := s.db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	for _, c := range sqlColumns {
+		rows, err := s.db.Query(fmt.Sprintf("SELECT %s FROM %s WHERE 1 = 0", c.column, c.table))
+		if err == nil {
+			rows.Close()
+			continue
+		}
+		if _, err = s.db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", c.table, c.column, c.definition)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// placeholders returns n bind variables start from the i-th
+func (s *SQLStorage) placeholders(i, n int) string {
+	vars := make([]string, n)
+	for j := range vars {
+		vars[j] = s.placeholder(i + j)
+	}
+	return strings.Join(vars, ",")
+}
+
+func (s *SQLStorage) encryptValue(value string) (string, error) {
+	data, err := AesEncrypt([]byte(value), []byte(s.secret))
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func (s *SQLStorage) decryptValue(value string) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return "", err
+	}
+	data, err = AesDecrypt(data, []byte(s.secret))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Store saves key and all its versions in a transaction
+func (s *SQLStorage) Store(id string, key *Key) error {
+	return s.write(id, key, func(tx *sql.Tx) error {
+		// update first so that the key row is locked by the transaction
+		if n, err := s.updateKeyRow(tx, id, key, nil); err != nil || n > 0 {
+			return err
+		}
+		return s.insertKey(tx, id, key)
+	})
+}
+
+// CompareAndStore saves key only if the stored key equals old. The key row is updated
+// only if its revision is unchanged since it's read in the transaction.
+func (s *SQLStorage) CompareAndStore(id string, old, key *Key) error {
+	err := s.write(id, key, func(tx *sql.Tx) error {
+		keys, revisions, err := s.load(tx, []string{id})
+		if err != nil {
+			return err
+		}
+		if !sameKey(keys[id], old) {
+			return ErrConflict
+		}
+		if old == nil {
+			return s.insertKey(tx, id, key)
+		}
+
+		revision := revisions[id]
+		n, err := s.updateKeyRow(tx, id, key, &revision)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrConflict
+		}
+		return nil
+	})
+	if err != nil && err != ErrConflict && old == nil {
+		// insert failed because the key is created concurrently
+		if keys, e := s.LoadMany([]string{id}); e == nil && keys[id] != nil {
+			return ErrConflict
+		}
+	}
+	return err
+}
+
+// write writes key row by writeKey, then versions of the key in a transaction
+func (s *SQLStorage) write(id string, key *Key, writeKey func(tx *sql.Tx) error) (err error) {
+	if key == nil {
+		return errors.New("nil key")
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = writeKey(tx); err != nil {
+		return
+	}
+	if err = s.writeVersions(tx, id, key); err != nil {
+		return
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLStorage) insertKey(tx *sql.Tx, id string, key *Key) error {
+	_, err := tx.Exec(
+		fmt.Sprintf(
+			"INSERT INTO keyservice_keys (version, rotation_period, state, deletion_time, previous_state, mode, updated_at, id) VALUES (%s)",
+			s.placeholders(1, 8),
+		),
+		s.keyRowValues(id, key)...,
+	)
+	return err
+}
+
+// updateKeyRow updates the key row and increases its revision, returns the number of updated rows.
+// If revision isn't nil, the row is updated only if its revision is unchanged.
+func (s *SQLStorage) updateKeyRow(tx *sql.Tx, id string, key *Key, revision *int64) (int64, error) {
+	stmt := fmt.Sprintf(
+		"UPDATE keyservice_keys SET version = %s, rotation_period = %s, state = %s, deletion_time = %s, previous_state = %s, mode = %s, revision = revision + 1, updated_at = %s WHERE id = %s",
+		s.placeholder(1), s.placeholder(2), s.placeholder(3), s.placeholder(4), s.placeholder(5), s.placeholder(6), s.placeholder(7), s.placeholder(8),
+	)
+	args := s.keyRowValues(id, key)
+	if revision != nil {
+		stmt += " AND revision = " + s.placeholder(9)
+		args = append(args, *revision)
+	}
+	res, err := tx.Exec(stmt, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// keyRowValues returns values of version, rotation_period, state, deletion_time, previous_state, mode, updated_at, id
+func (s *SQLStorage) keyRowValues(id string, key *Key) []interface{} {
+	return []interface{}{
+		key.Version, key.RotationPeriod, string(key.State), key.DeletionTime, string(key.PreviousState), string(key.Mode), time.Now().Unix(), id,
+	}
+}
+
+// writeVersions upserts versions of key and removes versions not in key
+func (s *SQLStorage) writeVersions(tx *sql.Tx, id string, key *Key) error {
+	rows, err := tx.Query("SELECT version FROM keyservice_key_versions WHERE key_id = "+s.placeholder(1), id)
+	if err != nil {
+		return err
+	}
+	stored := make(map[uint16]bool)
+	for rows.Next() {
+		var version uint16
+		if err = rows.Scan(&version); err != nil {
+			rows.Close()
+			return err
+		}
+		stored[version] = true
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	var (
+		insert = fmt.Sprintf(
+			"INSERT INTO keyservice_key_versions (state, value, created_at, updated_at, key_id, version) VALUES (%s)",
+			s.placeholders(1, 6),
+		)
+		update = fmt.Sprintf(
+			"UPDATE keyservice_key_versions SET state = %s, value = %s, created_at = %s, updated_at = %s WHERE key_id = %s AND version = %s",
+			s.placeholder(1), s.placeholder(2), s.placeholder(3), s.placeholder(4), s.placeholder(5), s.placeholder(6),
+		)
+	)
+	for _, v := range key.Versions {
+		value, err := s.encryptValue(v.Value)
+		if err != nil {
+			return err
+		}
+		stmt := insert
+		if stored[v.Version] {
+			stmt = update
+			delete(stored, v.Version)
+		}
+		if _, err = tx.Exec(stmt, string(v.State), value, v.CreatedAt, v.UpdatedAt, id, v.Version); err != nil {
+			return err
+		}
+	}
+
+	for version := range stored {
+		_, err = tx.Exec(
+			fmt.Sprintf("DELETE FROM keyservice_key_versions WHERE key_id = %s AND version = %s", s.placeholder(1), s.placeholder(2)),
+			id, version,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete removes key and all its versions
+func (s *SQLStorage) Delete(id string) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err = s.delete(tx, id); err != nil {
+		tx.Rollback()
+		return
+	}
+	return tx.Commit()
+}
+
+// CompareAndDelete removes key only if the stored key equals old and its revision is unchanged
+// since it's read in the transaction
+func (s *SQLStorage) CompareAndDelete(id string, old *Key) (err error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	keys, revisions, err := s.load(tx, []string{id})
+	if err != nil {
+		return
+	}
+	if !sameKey(keys[id], old) {
+		return ErrConflict
+	}
+	if old == nil {
+		return tx.Commit()
+	}
+
+	res, err := tx.Exec(
+		fmt.Sprintf("DELETE FROM keyservice_keys WHERE id = %s AND revision = %s", s.placeholder(1), s.placeholder(2)),
+		id, revisions[id],
+	)
+	if err != nil {
+		return
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return
+	}
+	if n == 0 {
+		return ErrConflict
+	}
+	if _, err = tx.Exec("DELETE FROM keyservice_key_versions WHERE key_id = "+s.placeholder(1), id); err != nil {
+		return
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLStorage) delete(tx *sql.Tx, id string) error {
+	if _, err := tx.Exec("DELETE FROM keyservice_key_versions WHERE key_id = "+s.placeholder(1), id); err != nil {
+		return err
+	}
+	_, err := tx.Exec("DELETE FROM keyservice_keys WHERE id = "+s.placeholder(1), id)
+	return err
+}
+
+func (s *SQLStorage) LoadMany(ids []string) (map[string]*Key, error) {
+	keys, _, err := s.load(s.db, ids)
+	return keys, err
+}
+
+// sqlQueryer is *sql.DB or *sql.Tx
+type sqlQueryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// load reads keys and their versions in a single statement, so that a key and its versions
+// are from the same snapshot. Revisions of the keys are returned as well.
+func (s *SQLStorage) load(q sqlQueryer, ids []string) (ret map[string]*Key, revisions map[string]int64, err error) {
+	ret = make(map[string]*Key)
+	revisions = make(map[string]int64)
+	if len(ids) == 0 {
+		return
+	}
+
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+
+	rows, err := q.Query(
+		fmt.Sprintf(
+			"SELECT k.id, k.version, k.rotation_period, k.state, k.deletion_time, k.previous_state, k.mode, k.revision, "+
+				"v.version, v.state, v.value, v.created_at, v.updated_at "+
+				"FROM keyservice_keys k LEFT JOIN keyservice_key_versions v ON v.key_id = k.id "+
+				"WHERE k.id IN (%s) ORDER BY k.id, v.version",
+			s.placeholders(1, len(ids)),
+		),
+		args...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			id, state, prevState, mode string
+			revision                   int64
+			key                        = &Key{}
+			// versions are NULL if the key has no versions
+			version, createdAt, updatedAt sql.NullInt64
+			vstate, value                 sql.NullString
+		)
+		err = rows.Scan(
+			&id, &key.Version, &key.RotationPeriod, &state, &key.DeletionTime, &prevState, &mode, &revision,
+			&version, &vstate, &value, &createdAt, &updatedAt,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ret[id] == nil {
+			key.State = KeyState(state)
+			key.PreviousState = KeyState(prevState)
+			key.Mode = EncryptionMode(mode)
+			ret[id] = key
+			revisions[id] = revision
+		}
+		if !version.Valid {
+			continue
+		}
+		v := &KeyVersion{
+			Version:   uint16(version.Int64),
+			State:     KeyState(vstate.String),
+			CreatedAt: createdAt.Int64,
+			UpdatedAt: updatedAt.Int64,
+		}
+		if v.Value, err = s.decryptValue(value.String); err != nil {
+			return nil, nil, err
+		}
+		ret[id].Versions = append(ret[id].Versions, v)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return ret, revisions, nil
+}
+
+// IDs returns ids of all keys
+func (s *SQLStorage) IDs() ([]string, error) {
+	rows, err := s.db.Query("SELECT id FROM keyservice_keys ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
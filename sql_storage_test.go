@@ -0,0 +1,134 @@
+package keyservice
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLStorage(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	s := NewSQLStorage(db, "sqlite3", "secret-key")
+	require.NoError(t, s.CreateTables())
+	// idempotent
+	require.NoError(t, s.CreateTables())
+
+	key1 := NewKey("key1-value")
+	key1.RotationPeriod = 86400
+	key1.Mode = EncryptionModeDeterministic
+	require.NoError(t, s.Store("key1", key1))
+	require.NoError(t, s.Store("key2", _testKey2))
+
+	keys, err := s.LoadMany([]string{"key1", "key2", "key-not-exists"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+	assert.EqualValues(t, key1, keys["key1"])
+	assert.EqualValues(t, _testKey2, keys["key2"])
+
+	// key values are encrypted
+	var value string
+	require.NoError(t, db.QueryRow("SELECT value FROM keyservice_key_versions WHERE key_id = 'key1'").Scan(&value))
+	assert.NotContains(t, value, "key1-value")
+
+	// overwrite
+	sv := NewKeyService("seed-key", s, NoCache)
+	key, err := sv.RotateKey("key1")
+	require.NoError(t, err)
+	keys, err = s.LoadMany([]string{"key1"})
+	require.NoError(t, err)
+	assert.EqualValues(t, key, keys["key1"])
+
+	// conditional write
+	assert.Equal(t, ErrConflict, s.CompareAndStore("key1", key1, key1))
+	assert.Equal(t, ErrConflict, s.CompareAndStore("key1", nil, key1))
+	require.NoError(t, s.CompareAndStore("key1", key, key1))
+	keys, err = s.LoadMany([]string{"key1"})
+	require.NoError(t, err)
+	assert.EqualValues(t, key1, keys["key1"])
+	var count int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM keyservice_key_versions WHERE key_id = 'key1'").Scan(&count))
+	assert.Equal(t, 1, count)
+
+	// concurrent rotations of service instances sharing the database
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sv := NewKeyService("seed-key", NewSQLStorage(db, "sqlite3", "secret-key"), NoCache)
+			for j := 0; j < 5; j++ {
+				_, err := sv.RotateKey("key1")
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+	keys, err = s.LoadMany([]string{"key1"})
+	require.NoError(t, err)
+	assert.Equal(t, uint16(21), keys["key1"].Version)
+	assert.Equal(t, 21, len(keys["key1"].Versions))
+	require.NoError(t, s.CompareAndStore("key1", keys["key1"], key))
+
+	ids, err := s.IDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key1", "key2"}, ids)
+
+	require.NoError(t, s.Delete("key1"))
+	keys, err = s.LoadMany([]string{"key1", "key2"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(keys))
+
+	key3 := NewKey("key3-value")
+	key3.State = KeyStatePendingDeletion
+	key3.PreviousState = KeyStateDisabled
+	key3.DeletionTime = time.Now().Unix()
+	require.NoError(t, s.CompareAndStore("key3", nil, key3))
+	keys, err = s.LoadMany([]string{"key3"})
+	require.NoError(t, err)
+	assert.EqualValues(t, key3, keys["key3"])
+	assert.Equal(t, ErrConflict, s.CompareAndDelete("key3", key1))
+	require.NoError(t, s.CompareAndDelete("key3", key3))
+	keys, err = s.LoadMany([]string{"key3"})
+	require.NoError(t, err)
+	assert.Nil(t, keys["key3"])
+
+	// wrong secret
+	_, err = NewSQLStorage(db, "sqlite3", "secret-key-2").LoadMany([]string{"key2"})
+	assert.NotNil(t, err)
+}
+
+func TestSQLStorageAddColumns(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	// table created before revision column is added
+	_, err = db.Exec(`CREATE TABLE keyservice_keys (
+		id              VARCHAR(128) NOT NULL PRIMARY KEY,
+		version         INTEGER NOT NULL,
+		rotation_period BIGINT NOT NULL DEFAULT 0,
+		state           VARCHAR(16) NOT NULL DEFAULT '',
+		deletion_time   BIGINT NOT NULL DEFAULT 0,
+		mode            VARCHAR(16) NOT NULL DEFAULT '',
+		updated_at      BIGINT NOT NULL DEFAULT 0
+	)`)
+	require.NoError(t, err)
+
+	s := NewSQLStorage(db, "sqlite3", "secret-key")
+	require.NoError(t, s.CreateTables())
+	require.NoError(t, s.CreateTables())
+	require.NoError(t, s.Store("key1", _testKey2))
+	keys, err := s.LoadMany([]string{"key1"})
+	require.NoError(t, err)
+	assert.EqualValues(t, _testKey2, keys["key1"])
+}
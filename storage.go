@@ -1,17 +1,22 @@
 package keyservice
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"sort"
 	"sync"
-	"time"
+
+	"github.com/fsnotify/fsnotify"
 )
 
 var (
 	ErrMethodNotImplemented = errors.New("method not implemented")
+	ErrConflict             = errors.New("Key was modified concurrently")
 )
 
 type Storage interface {
@@ -19,12 +24,57 @@ type Storage interface {
 	LoadMany(ids []string) (map[string]*Key, error)
 }
 
+// IterableStorage is Storage that can list ids of all keys, ids are sorted
+type IterableStorage interface {
+	Storage
+	IDs() ([]string, error)
+}
+
+// DeletableStorage is Storage that can delete keys
+type DeletableStorage interface {
+	Storage
+	Delete(id string) error
+}
+
+// ConditionalStorage is Storage that writes keys conditionally, so that service instances sharing
+// the storage don't overwrite each other's updates
+type ConditionalStorage interface {
+	Storage
+	// CompareAndStore saves key only if the stored key equals old, nil old means the key must not exist.
+	// ErrConflict is returned if the stored key is different.
+	CompareAndStore(id string, old, key *Key) error
+	// CompareAndDelete deletes key only if the stored key equals old, ErrConflict is returned otherwise.
+	CompareAndDelete(id string, old *Key) error
+}
+
+// sameKey reports whether a and b have the same content, nil means absent
+func sameKey(a, b *Key) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	da, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	db, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(da, db)
+}
+
 type FileStorage struct {
 	mu sync.RWMutex
 	path string
 	secret string
 	data map[string]*Key
-	lastModified time.Time
+	// file info of the last loaded keystore file
+	fileInfo os.FileInfo
+
+	// channels of Watch
+	watchers    map[chan KeyEvent]struct{}
+	watcherOnce sync.Once
+	watcher     *fsnotify.Watcher
 }
 
 func NewFileStorage(path string, secret string) (*FileStorage, error) {
@@ -32,6 +82,7 @@ func NewFileStorage(path string, secret string) (*FileStorage, error) {
 		path: path,
 		secret: secret,
 		data: make(map[string]*Key),
+		watchers: make(map[chan KeyEvent]struct{}),
 	}
 	if err := s.load(); err != nil {
 		return nil, err
@@ -39,8 +90,143 @@ func NewFileStorage(path string, secret string) (*FileStorage, error) {
 	return s, nil
 }
 
+// Store saves key to keystore file.
+// The whole keystore is re-encrypted and written to a temporary file, then renamed to
+// keystore file atomically. An advisory file lock is held during the update so
+// that processes sharing the keystore file don't clobber each other.
 func (s *FileStorage) Store(id string, key *Key) error {
-	return ErrMethodNotImplemented
+	if key == nil {
+		return errors.New("nil key")
+	}
+	return s.update(func(data map[string]*Key) error {
+		data[id] = key.Copy()
+		return nil
+	})
+}
+
+// CompareAndStore saves key only if the key in keystore file equals old,
+// the comparison is done under the file lock.
+func (s *FileStorage) CompareAndStore(id string, old, key *Key) error {
+	if key == nil {
+		return errors.New("nil key")
+	}
+	return s.update(func(data map[string]*Key) error {
+		if !sameKey(data[id], old) {
+			return ErrConflict
+		}
+		data[id] = key.Copy()
+		return nil
+	})
+}
+
+// Delete removes key from keystore file
+func (s *FileStorage) Delete(id string) error {
+	return s.update(func(data map[string]*Key) error {
+		delete(data, id)
+		return nil
+	})
+}
+
+// CompareAndDelete removes key from keystore file only if it equals old
+func (s *FileStorage) CompareAndDelete(id string, old *Key) error {
+	return s.update(func(data map[string]*Key) error {
+		if !sameKey(data[id], old) {
+			return ErrConflict
+		}
+		delete(data, id)
+		return nil
+	})
+}
+
+func (s *FileStorage) update(fn func(data map[string]*Key) error) error {
+	unlock, err := lockFile(s.path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// reload under lock, the file may be modified by other processes
+	if err = s.load(); err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	data := make(map[string]*Key, len(s.data)+1)
+	for id, key := range s.data {
+		data[id] = key
+	}
+	s.mu.RUnlock()
+
+	if err = fn(data); err != nil {
+		return err
+	}
+
+	contents, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	contents, err = AesEncrypt(contents, []byte(s.secret))
+	if err != nil {
+		return err
+	}
+	contents = []byte(base64.RawURLEncoding.EncodeToString(contents))
+
+	if err = writeFileAtomic(s.path, contents, 0600); err != nil {
+		return err
+	}
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+
+	s.setData(data, info)
+
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file, fsyncs and renames it to filename
+func writeFileAtomic(filename string, data []byte, perm os.FileMode) (err error) {
+	dir, name := filepath.Split(filename)
+	if dir == "" {
+		dir = "."
+	}
+
+	f, err := ioutil.TempFile(dir, "."+name+".tmp")
+	if err != nil {
+		return err
+	}
+	tmpName := f.Name()
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(tmpName)
+		}
+	}()
+
+	if err = f.Chmod(perm); err != nil {
+		return
+	}
+	if _, err = f.Write(data); err != nil {
+		return
+	}
+	if err = f.Sync(); err != nil {
+		return
+	}
+	if err = f.Close(); err != nil {
+		return
+	}
+	if err = os.Rename(tmpName, filename); err != nil {
+		return
+	}
+
+	// sync directory to persist the rename
+	if d, e := os.Open(dir); e == nil {
+		d.Sync()
+		d.Close()
+	}
+
+	return nil
 }
 
 func (s *FileStorage) LoadMany(ids []string) (ret map[string]*Key, err error) {
@@ -60,6 +246,22 @@ func (s *FileStorage) LoadMany(ids []string) (ret map[string]*Key, err error) {
 	return ret, nil
 }
 
+func (s *FileStorage) IDs() ([]string, error) {
+	if s.modified() {
+		if err := s.load(); err != nil {
+			logger.Errorf("reload keystore file err:%s", err.Error())
+		}
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ids := make([]string, 0, len(s.data))
+	for id := range s.data {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
 func (s *FileStorage) modified() bool {
 	info, err := os.Stat(s.path)
 	if err != nil {
@@ -67,7 +269,13 @@ func (s *FileStorage) modified() bool {
 	}
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	return s.lastModified.Before(info.ModTime())
+	if s.fileInfo == nil {
+		return true
+	}
+	// file replaced by rename, or modified in place
+	return !os.SameFile(s.fileInfo, info) ||
+		!s.fileInfo.ModTime().Equal(info.ModTime()) ||
+		s.fileInfo.Size() != info.Size()
 }
 
 func (s *FileStorage) load() error {
@@ -91,9 +299,6 @@ func (s *FileStorage) load() error {
 	if err = json.Unmarshal(contents, &data); err != nil {
 		return err
 	}
-	s.mu.Lock()
-	s.data = data
-	s.lastModified = info.ModTime()
-	s.mu.Unlock()
+	s.setData(data, info)
 	return nil
 }
\ No newline at end of file
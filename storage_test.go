@@ -11,6 +11,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"testing"
 	"time"
@@ -20,13 +21,17 @@ var (
 	_testKeyId1 = "test-key-1"
 	_testKeyId2 = "test-key-2"
 	_testKey1   = &Key{
-		Value:   "test-key-1-value",
 		Version: 1,
+		Versions: []*KeyVersion{
+			{Version: 1, Value: "test-key-1-value", State: KeyStateActive},
+		},
 	}
 	_testKey2 = &Key{
-		Value:            "test-key-2-value-new",
-		Version:          2,
-		ValueWillExpired: "test-key-2-value",
+		Version: 2,
+		Versions: []*KeyVersion{
+			{Version: 1, Value: "test-key-2-value", State: KeyStateDecryptOnly},
+			{Version: 2, Value: "test-key-2-value-new", State: KeyStateActive},
+		},
 	}
 )
 
@@ -52,6 +57,21 @@ func (s *testStorage) Store(id string, key *Key) error {
 	return nil
 }
 
+func (s *testStorage) Delete(id string) error {
+	s.data.Delete(id)
+	return nil
+}
+
+func (s *testStorage) IDs() ([]string, error) {
+	ids := make([]string, 0)
+	s.data.Range(func(k, v interface{}) bool {
+		ids = append(ids, k.(string))
+		return true
+	})
+	sort.Strings(ids)
+	return ids, nil
+}
+
 func (s *testStorage) LoadMany(ids []string) (ret map[string]*Key, err error) {
 	ret = make(map[string]*Key)
 
@@ -88,15 +108,13 @@ func TestNewFileStorage(t *testing.T) {
 	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-%d", time.Now().UnixNano()+rand.Int63n(1000)))
 	secret := "secret-key"
 	data := map[string]*Key{
-		"key1" : &Key{
-			Version: 1,
-			Value: "key1-value",
-			ValueWillExpired: "",
-		},
-		"key2" : &Key{
+		"key1": NewKey("key1-value"),
+		"key2": &Key{
 			Version: 2,
-			Value: "key2-value-2",
-			ValueWillExpired: "key2-value-1",
+			Versions: []*KeyVersion{
+				{Version: 1, Value: "key2-value-1", State: KeyStateDecryptOnly},
+				{Version: 2, Value: "key2-value-2", State: KeyStateActive},
+			},
 		},
 	}
 	err := writeFileKeyStoreContents(file, data, secret)
@@ -112,12 +130,105 @@ func TestNewFileStorage(t *testing.T) {
 	// modify file
 	data["key1"] = &Key{
 		Version: 2,
-		Value: "key1-value-2",
-		ValueWillExpired: "key1-value",
+		Versions: []*KeyVersion{
+			{Version: 1, Value: "key1-value", State: KeyStateDecryptOnly},
+			{Version: 2, Value: "key1-value-2", State: KeyStateActive},
+		},
 	}
 	err = writeFileKeyStoreContents(file, data, secret)
 	require.NoError(t, err)
 	keys, err = s.LoadMany([]string{"key1", "key2"})
 	require.NoError(t, err)
 	assert.EqualValues(t, keys, data)
-}
\ No newline at end of file
+}
+
+func TestFileStorageLegacyFormat(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	secret := "secret-key"
+	contents, err := AesEncrypt([]byte(`{"key1":{"n":1,"v":"key1-value"},"key2":{"n":2,"v":"key2-value-2","o":"key2-value-1"}}`), []byte(secret))
+	require.NoError(t, err)
+	err = ioutil.WriteFile(file, []byte(base64.RawURLEncoding.EncodeToString(contents)), 0600)
+	require.NoError(t, err)
+	defer os.Remove(file)
+
+	s, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+	keys, err := s.LoadMany([]string{"key1", "key2"})
+	require.NoError(t, err)
+	assert.EqualValues(t, map[string]*Key{
+		"key1": &Key{
+			Version: 1,
+			Versions: []*KeyVersion{
+				{Version: 1, Value: "key1-value", State: KeyStateActive},
+			},
+		},
+		"key2": &Key{
+			Version: 2,
+			Versions: []*KeyVersion{
+				{Version: 1, Value: "key2-value-1", State: KeyStateDecryptOnly},
+				{Version: 2, Value: "key2-value-2", State: KeyStateActive},
+			},
+		},
+	}, keys)
+}
+
+func TestFileStorageStore(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	secret := "secret-key"
+	err := writeFileKeyStoreContents(file, map[string]*Key{"key1": NewKey("key1-value")}, secret)
+	require.NoError(t, err)
+	defer os.Remove(file)
+	defer os.Remove(file + ".lock")
+
+	s1, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+	s2, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+
+	key2 := NewKey("key2-value")
+	require.NoError(t, s1.Store("key2", key2))
+	keys, err := s1.LoadMany([]string{"key1", "key2"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+	assert.EqualValues(t, key2, keys["key2"])
+
+	// written by other storage instance
+	keys, err = s2.LoadMany([]string{"key1", "key2"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+	assert.EqualValues(t, key2, keys["key2"])
+
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// concurrent writes from different storage instances
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s := s1
+			if i%2 == 0 {
+				s = s2
+			}
+			assert.NoError(t, s.Store(fmt.Sprintf("key-%d", i), NewKey(fmt.Sprintf("value-%d", i))))
+		}(i)
+	}
+	wg.Wait()
+
+	ids, err := s1.IDs()
+	require.NoError(t, err)
+	assert.Equal(t, 12, len(ids))
+
+	require.NoError(t, s2.Delete("key1"))
+	keys, err = s1.LoadMany([]string{"key1", "key2"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(keys))
+	assert.Nil(t, keys["key1"])
+
+	// no temporary file left
+	matches, err := filepath.Glob(filepath.Join(filepath.Dir(file), "."+filepath.Base(file)+".tmp*"))
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
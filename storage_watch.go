@@ -0,0 +1,176 @@
+package keyservice
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// KeyEventType is the type of key change
+type KeyEventType int
+
+const (
+	KeyUpdated KeyEventType = iota + 1
+	KeyDeleted
+)
+
+// KeyEvent notifies that a key is changed
+type KeyEvent struct {
+	ID   string
+	Type KeyEventType
+}
+
+// WatchableStorage is Storage that notifies key changes
+type WatchableStorage interface {
+	Storage
+	// Watch returns channel of key events, the channel is closed when ctx is done
+	Watch(ctx context.Context) <-chan KeyEvent
+}
+
+// 事件通道缓冲大小，通道已满时丢弃事件
+var keyEventBufferSize = 256
+
+// Watch returns channel of key events. Changes are detected when the keystore file is
+// written by this storage or other processes.
+func (s *FileStorage) Watch(ctx context.Context) <-chan KeyEvent {
+	ch := make(chan KeyEvent, keyEventBufferSize)
+
+	s.mu.Lock()
+	s.watchers[ch] = struct{}{}
+	s.mu.Unlock()
+
+	s.watcherOnce.Do(func() {
+		if err := s.startWatcher(); err != nil {
+			logger.Errorf("watch keystore file err:%v", err)
+		}
+	})
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		delete(s.watchers, ch)
+		close(ch)
+		s.mu.Unlock()
+	}()
+
+	return ch
+}
+
+// Close stops watching keystore file
+func (s *FileStorage) Close() error {
+	s.mu.Lock()
+	watcher := s.watcher
+	s.watcher = nil
+	s.mu.Unlock()
+	if watcher != nil {
+		return watcher.Close()
+	}
+	return nil
+}
+
+// startWatcher watches the directory of keystore file,
+// because the file is replaced by rename when updated.
+func (s *FileStorage) startWatcher() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err = watcher.Add(filepath.Dir(s.path)); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	s.mu.Lock()
+	s.watcher = watcher
+	s.mu.Unlock()
+
+	path := filepath.Clean(s.path)
+
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != path {
+					continue
+				}
+				if s.modified() {
+					if err := s.load(); err != nil {
+						logger.Errorf("reload keystore file err:%s", err.Error())
+					}
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Errorf("watch keystore file err:%v", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// setData replaces keys and notifies watchers of changed keys
+func (s *FileStorage) setData(data map[string]*Key, info os.FileInfo) {
+	s.mu.Lock()
+	events := diffKeys(s.data, data)
+	s.data = data
+	s.fileInfo = info
+	s.mu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for ch := range s.watchers {
+		for _, event := range events {
+			select {
+			case ch <- event:
+			default:
+				logger.Errorf("key event channel is full, drop event of key=%s", event.ID)
+			}
+		}
+	}
+}
+
+// diffKeys returns events of keys changed from old to new
+func diffKeys(old, new map[string]*Key) (events []KeyEvent) {
+	for id, key := range new {
+		if !reflect.DeepEqual(old[id], key) {
+			events = append(events, KeyEvent{ID: id, Type: KeyUpdated})
+		}
+	}
+	for id := range old {
+		if _, ok := new[id]; !ok {
+			events = append(events, KeyEvent{ID: id, Type: KeyDeleted})
+		}
+	}
+	return
+}
+
+// WatchStorage expires cached keys as soon as they are changed in storage until ctx is done,
+// it returns false if storage is not WatchableStorage.
+func (sv *KeyService) WatchStorage(ctx context.Context) bool {
+	storage, ok := sv.storage.(WatchableStorage)
+	if !ok {
+		return false
+	}
+
+	events := storage.Watch(ctx)
+	go func() {
+		for event := range events {
+			logger.Debugf("key=%s changed, event type=%d", event.ID, event.Type)
+			sv.cache.Expire(event.ID)
+		}
+	}()
+
+	return true
+}
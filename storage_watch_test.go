@@ -0,0 +1,95 @@
+package keyservice
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileStorageWatch(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	secret := "secret-key"
+	err := writeFileKeyStoreContents(file, map[string]*Key{"key1": NewKey("key1-value")}, secret)
+	require.NoError(t, err)
+	defer os.Remove(file)
+	defer os.Remove(file + ".lock")
+
+	s1, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+	s2, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+	defer s2.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := s2.Watch(ctx)
+
+	waitEvent := func() KeyEvent {
+		select {
+		case event := <-events:
+			return event
+		case <-time.After(5 * time.Second):
+			t.Fatal("wait key event timeout")
+		}
+		return KeyEvent{}
+	}
+
+	// written by other storage instance
+	require.NoError(t, s1.Store("key2", NewKey("key2-value")))
+	assert.Equal(t, KeyEvent{ID: "key2", Type: KeyUpdated}, waitEvent())
+
+	require.NoError(t, s1.Delete("key1"))
+	assert.Equal(t, KeyEvent{ID: "key1", Type: KeyDeleted}, waitEvent())
+
+	// written by itself
+	require.NoError(t, s2.Store("key3", NewKey("key3-value")))
+	assert.Equal(t, KeyEvent{ID: "key3", Type: KeyUpdated}, waitEvent())
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestServiceStorageWatcher(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	secret := "secret-key"
+	err := writeFileKeyStoreContents(file, map[string]*Key{"key1": NewKey("key1-value")}, secret)
+	require.NoError(t, err)
+	defer os.Remove(file)
+	defer os.Remove(file + ".lock")
+
+	s1, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+	s2, err := NewFileStorage(file, secret)
+	require.NoError(t, err)
+	defer s2.Close()
+
+	sv := NewKeyService("seed-key", s2, newTestCache())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.True(t, sv.WatchStorage(ctx))
+	assert.False(t, NewKeyService("seed-key", newTestStorage(), NoCache).WatchStorage(ctx))
+
+	require.NotNil(t, sv.GetKey("key1"))
+	assert.Equal(t, uint16(1), sv.GetKey("key1").Version)
+
+	// rotated by other process
+	_, err = NewKeyService("seed-key", s1, NoCache).RotateKey("key1")
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		key := sv.GetKey("key1")
+		return key != nil && key.Version == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// revoked by other process
+	require.NoError(t, s1.Delete("key1"))
+	assert.Eventually(t, func() bool {
+		return sv.GetKey("key1") == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
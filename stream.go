@@ -0,0 +1,287 @@
+package keyservice
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var ErrStreamClosed = errors.New("Stream closed")
+
+// Stream format:
+// header: format(1 byte)+version(2 bytes)+chunk size(4 bytes)+salt(16 bytes)
+// chunks: AES-256-GCM(chunk)+tag(16 bytes), every chunk is chunk size bytes except the last one.
+// Each stream is encrypted with its own key: HMAC-SHA256(derived key, salt),
+// chunk nonce is counter(11 bytes)+last chunk flag(1 byte), so chunks can't be reordered or truncated.
+const (
+	formatStream byte = 0x02
+
+	streamSaltSize   = 16
+	streamHeaderSize = envelopeHeaderSize + 4 + streamSaltSize
+)
+
+var (
+	// 默认分块大小
+	defaultStreamChunkSize = 64 * 1024
+	// 解密时允许的最大分块大小，防止恶意数据导致分配过多内存
+	maxStreamChunkSize = 16 * 1024 * 1024
+)
+
+type streamCipher struct {
+	aead    cipher.AEAD
+	ad      []byte
+	counter uint64
+	nonce   []byte
+}
+
+func (sv *KeyService) newStreamCipher(header []byte, keyValue string, keyID string, context map[string]string) (*streamCipher, error) {
+	mac := hmac.New(sha256.New, sv.deriveKey(keyValue))
+	mac.Write(header[streamHeaderSize-streamSaltSize : streamHeaderSize])
+
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &streamCipher{
+		aead:  aead,
+		ad:    additionalData(header, keyID, context),
+		nonce: make([]byte, aead.NonceSize()),
+	}, nil
+}
+
+func (c *streamCipher) next(last bool) []byte {
+	nonce := c.nonce
+	for i := range nonce {
+		nonce[i] = 0
+	}
+	binary.BigEndian.PutUint64(nonce[len(nonce)-9:], c.counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	c.counter++
+	return nonce
+}
+
+func (c *streamCipher) seal(dst, chunk []byte, last bool) []byte {
+	return c.aead.Seal(dst, c.next(last), chunk, c.ad)
+}
+
+func (c *streamCipher) open(dst, chunk []byte, last bool) ([]byte, error) {
+	text, err := c.aead.Open(dst, c.next(last), chunk, c.ad)
+	if err != nil {
+		return nil, ErrSignatureError
+	}
+	return text, nil
+}
+
+type encryptWriter struct {
+	w         io.Writer
+	cipher    *streamCipher
+	chunkSize int
+	buf       []byte
+	out       []byte
+	err       error
+}
+
+// NewEncryptWriter returns a writer that encrypts data with key specified by keyID and writes it to w
+// in chunked stream format, context is bound to the stream like EncryptWithContext.
+// Close must be called to write the last chunk, it doesn't close w.
+func (sv *KeyService) NewEncryptWriter(w io.Writer, keyID string, context map[string]string) (io.WriteCloser, error) {
+	key, err := sv.LookupKey(keyID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.IsActive() {
+		return nil, ErrKeyDisabled
+	}
+
+	chunkSize := defaultStreamChunkSize
+	header := make([]byte, streamHeaderSize)
+	putEnvelopeHeader(header, formatStream, key.Version)
+	binary.BigEndian.PutUint32(header[envelopeHeaderSize:], uint32(chunkSize))
+	salt, err := randomBytes(streamSaltSize)
+	if err != nil {
+		return nil, err
+	}
+	copy(header[streamHeaderSize-streamSaltSize:], salt)
+
+	c, err := sv.newStreamCipher(header, key.Current().Value, keyID, context)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = w.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &encryptWriter{
+		w:         w,
+		cipher:    c,
+		chunkSize: chunkSize,
+		buf:       make([]byte, 0, chunkSize),
+		out:       make([]byte, 0, chunkSize+c.aead.Overhead()),
+	}, nil
+}
+
+func (ew *encryptWriter) Write(p []byte) (n int, err error) {
+	if ew.err != nil {
+		return 0, ew.err
+	}
+
+	for len(p) > 0 {
+		// 缓冲区满且还有后续数据时才输出，保证最后一个分块在Close时输出
+		if len(ew.buf) == ew.chunkSize {
+			if err = ew.flush(false); err != nil {
+				return
+			}
+		}
+		m := copy(ew.buf[len(ew.buf):ew.chunkSize], p)
+		ew.buf = ew.buf[:len(ew.buf)+m]
+		n += m
+		p = p[m:]
+	}
+
+	return
+}
+
+func (ew *encryptWriter) flush(last bool) error {
+	ew.out = ew.cipher.seal(ew.out[:0], ew.buf, last)
+	ew.buf = ew.buf[:0]
+	if _, err := ew.w.Write(ew.out); err != nil {
+		ew.err = err
+		return err
+	}
+	return nil
+}
+
+// Close writes the last chunk
+func (ew *encryptWriter) Close() error {
+	if ew.err != nil {
+		if ew.err == ErrStreamClosed {
+			return nil
+		}
+		return ew.err
+	}
+	if err := ew.flush(true); err != nil {
+		return err
+	}
+	ew.err = ErrStreamClosed
+	return nil
+}
+
+type decryptReader struct {
+	r      *bufio.Reader
+	cipher *streamCipher
+	chunk  []byte
+	text   []byte
+	eof    bool
+	err    error
+}
+
+// NewDecryptReader returns a reader that decrypts stream generated by NewEncryptWriter from r.
+// Read returns ErrSignatureError if the stream was modified or truncated.
+func (sv *KeyService) NewDecryptReader(r io.Reader, keyID string, context map[string]string) (io.Reader, error) {
+	key, err := sv.LookupKey(keyID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.Enabled() {
+		return nil, ErrKeyDisabled
+	}
+
+	header := make([]byte, streamHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			err = ErrInvalidEncryptedData
+		}
+		return nil, err
+	}
+
+	format, version, _ := parseEnvelopeHeader(header)
+	if format != formatStream {
+		return nil, ErrInvalidEncryptedData
+	}
+
+	chunkSize := int(binary.BigEndian.Uint32(header[envelopeHeaderSize:]))
+	if chunkSize <= 0 || chunkSize > maxStreamChunkSize {
+		return nil, ErrInvalidEncryptedData
+	}
+
+	keyValue := key.valueOf(version)
+	if keyValue == "" {
+		return nil, ErrInvalidEncryptedData
+	}
+
+	c, err := sv.newStreamCipher(header, keyValue, keyID, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptReader{
+		r:      bufio.NewReader(r),
+		cipher: c,
+		chunk:  make([]byte, chunkSize+c.aead.Overhead()),
+	}, nil
+}
+
+func (dr *decryptReader) Read(p []byte) (n int, err error) {
+	for len(dr.text) == 0 {
+		if dr.err != nil {
+			return 0, dr.err
+		}
+		if dr.eof {
+			return 0, io.EOF
+		}
+		dr.err = dr.readChunk()
+	}
+
+	n = copy(p, dr.text)
+	dr.text = dr.text[n:]
+
+	return
+}
+
+func (dr *decryptReader) readChunk() error {
+	n, err := io.ReadFull(dr.r, dr.chunk)
+	last := false
+	switch err {
+	case nil:
+		// 分块已满，后面没有数据时为最后一个分块
+		if _, err = dr.r.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	case io.ErrUnexpectedEOF:
+		last = true
+	case io.EOF:
+		// 缺少最后一个分块
+		return ErrSignatureError
+	default:
+		return err
+	}
+
+	text, err := dr.cipher.open(dr.chunk[:0], dr.chunk[:n], last)
+	if err != nil {
+		return err
+	}
+
+	dr.text = text
+	dr.eof = last
+
+	return nil
+}
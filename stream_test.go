@@ -0,0 +1,114 @@
+package keyservice
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encryptStream(t *testing.T, sv *KeyService, data []byte, keyID string, context map[string]string) []byte {
+	var buf bytes.Buffer
+	w, err := sv.NewEncryptWriter(&buf, keyID, context)
+	require.NoError(t, err)
+	// 分多次写入
+	for len(data) > 0 {
+		n := rand.Intn(3*defaultStreamChunkSize/2) + 1
+		if n > len(data) {
+			n = len(data)
+		}
+		_, err = w.Write(data[:n])
+		require.NoError(t, err)
+		data = data[n:]
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decryptStream(sv *KeyService, data []byte, keyID string, context map[string]string) ([]byte, error) {
+	r, err := sv.NewDecryptReader(bytes.NewReader(data), keyID, context)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+func TestStream(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+	context := map[string]string{"file": "report.csv"}
+
+	for _, size := range []int{0, 1, defaultStreamChunkSize - 1, defaultStreamChunkSize, defaultStreamChunkSize + 1, 3*defaultStreamChunkSize + 7} {
+		data := make([]byte, size)
+		rand.Read(data)
+
+		encrypted := encryptStream(t, sv, data, _testKeyId2, context)
+		chunks := size/defaultStreamChunkSize + 1
+		if size > 0 && size%defaultStreamChunkSize == 0 {
+			chunks--
+		}
+		assert.Equal(t, streamHeaderSize+size+chunks*16, len(encrypted), "size=%d", size)
+
+		decrypted, err := decryptStream(sv, encrypted, _testKeyId2, context)
+		require.NoError(t, err, "size=%d", size)
+		assert.Equal(t, data, decrypted)
+
+		_, err = decryptStream(sv, encrypted, _testKeyId2, nil)
+		assert.Equal(t, ErrSignatureError, err)
+
+		// truncated
+		if size > defaultStreamChunkSize {
+			_, err = decryptStream(sv, encrypted[:streamHeaderSize+defaultStreamChunkSize+16], _testKeyId2, context)
+			assert.Equal(t, ErrSignatureError, err)
+		}
+		_, err = decryptStream(sv, encrypted[:streamHeaderSize], _testKeyId2, context)
+		assert.Equal(t, ErrSignatureError, err)
+
+		// modified
+		modified := append([]byte(nil), encrypted...)
+		modified[len(modified)-1] ^= 1
+		_, err = decryptStream(sv, modified, _testKeyId2, context)
+		assert.Equal(t, ErrSignatureError, err)
+	}
+
+	_, err := decryptStream(sv, []byte("invalid"), _testKeyId2, nil)
+	assert.Equal(t, ErrInvalidEncryptedData, err)
+	_, err = sv.NewEncryptWriter(ioutil.Discard, "key-not-exists-id", nil)
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestStreamKeyVersions(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	data := []byte("hello,world!")
+	encrypted := encryptStream(t, sv, data, _testKeyId2, nil)
+
+	_, err := sv.RotateKey(_testKeyId2)
+	require.NoError(t, err)
+
+	decrypted, err := decryptStream(sv, encrypted, _testKeyId2, nil)
+	require.NoError(t, err)
+	assert.Equal(t, data, decrypted)
+
+	_, err = sv.DisableKey(_testKeyId2)
+	require.NoError(t, err)
+	_, err = sv.NewDecryptReader(bytes.NewReader(encrypted), _testKeyId2, nil)
+	assert.Equal(t, ErrKeyDisabled, err)
+}
+
+func TestEncryptWriterClose(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	w, err := sv.NewEncryptWriter(ioutil.Discard, _testKeyId1, nil)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	assert.NoError(t, w.Close())
+	_, err = w.Write([]byte("hello"))
+	assert.Equal(t, ErrStreamClosed, err)
+
+	_, err = io.Copy(w, bytes.NewReader([]byte("hello")))
+	assert.Equal(t, ErrStreamClosed, err)
+}
@@ -0,0 +1,292 @@
+package keyservice
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	ErrClientCARequired   = errors.New("Client CA required to verify client certificates")
+	ErrServerNameRequired = errors.New("Server name required to verify server certificate")
+)
+
+// 证书文件变更检查的最小间隔
+var certCheckInterval = time.Second
+
+// TLSOptions are options of server or client TLS config
+type TLSOptions struct {
+	CertFile string // 证书文件(PEM)
+	KeyFile  string // 私钥文件(PEM)
+	// 服务端: 验证客户端证书的CA文件; 客户端: 验证服务端证书的CA文件，为空时使用系统CA
+	CAFile string
+	// 服务端是否要求客户端证书(mTLS)
+	RequireClientCert bool
+	// 客户端验证的服务端名称，为空时使用连接地址
+	ServerName string
+}
+
+// CertReloader loads certificate and CA bundle from files,
+// and reloads them when files are changed, so that certificates can be renewed without restart.
+type CertReloader struct {
+	certFile, keyFile, caFile string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	caPool    *x509.CertPool
+	fileInfos []os.FileInfo
+	checkedAt time.Time
+}
+
+// NewCertReloader returns *CertReloader, certFile and keyFile, or caFile could be empty
+func NewCertReloader(certFile, keyFile, caFile string) (*CertReloader, error) {
+	r := &CertReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *CertReloader) files() []string {
+	return []string{r.certFile, r.keyFile, r.caFile}
+}
+
+func (r *CertReloader) load() error {
+	fileInfos := make([]os.FileInfo, 0, 3)
+	for _, file := range r.files() {
+		if file == "" {
+			fileInfos = append(fileInfos, nil)
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		fileInfos = append(fileInfos, info)
+	}
+
+	var (
+		cert   *tls.Certificate
+		caPool *x509.CertPool
+	)
+	if r.certFile != "" || r.keyFile != "" {
+		c, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+		if err != nil {
+			return err
+		}
+		cert = &c
+	}
+	if r.caFile != "" {
+		contents, err := ioutil.ReadFile(r.caFile)
+		if err != nil {
+			return err
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(contents) {
+			return errors.New("no certificate found in " + r.caFile)
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = cert
+	r.caPool = caPool
+	r.fileInfos = fileInfos
+	r.checkedAt = time.Now()
+	r.mu.Unlock()
+
+	return nil
+}
+
+// reload reloads files if they are changed since last loaded
+func (r *CertReloader) reload() {
+	r.mu.RLock()
+	checkedAt, fileInfos := r.checkedAt, r.fileInfos
+	r.mu.RUnlock()
+	if time.Since(checkedAt) < certCheckInterval {
+		return
+	}
+
+	modified := false
+	for i, file := range r.files() {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			// 文件可能正在替换，下次再检查
+			continue
+		}
+		old := fileInfos[i]
+		if !os.SameFile(old, info) || !old.ModTime().Equal(info.ModTime()) || old.Size() != info.Size() {
+			modified = true
+			break
+		}
+	}
+
+	if !modified {
+		r.mu.Lock()
+		r.checkedAt = time.Now()
+		r.mu.Unlock()
+		return
+	}
+
+	if err := r.load(); err != nil {
+		// 继续使用旧证书
+		logger.Errorf("reload certificates err=%v", err)
+		r.mu.Lock()
+		r.checkedAt = time.Now()
+		r.mu.Unlock()
+		return
+	}
+
+	logger.Infof("reload certificates cert=%s ca=%s", r.certFile, r.caFile)
+}
+
+// Certificate returns the current certificate
+func (r *CertReloader) Certificate() *tls.Certificate {
+	r.reload()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert
+}
+
+// CAPool returns the current CA pool
+func (r *CertReloader) CAPool() *x509.CertPool {
+	r.reload()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.caPool
+}
+
+// GetCertificate is for tls.Config.GetCertificate
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.Certificate(), nil
+}
+
+// GetClientCertificate is for tls.Config.GetClientCertificate
+func (r *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	if cert := r.Certificate(); cert != nil {
+		return cert, nil
+	}
+	// 没有证书时不发送
+	return &tls.Certificate{}, nil
+}
+
+// verifyClientCertificate verifies client certificates with the current CA pool,
+// it's used instead of tls.Config.ClientCAs which can't be reloaded.
+func (r *CertReloader) verifyClientCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		// RequireAnyClientCert rejects empty certificates before this
+		return nil
+	}
+
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+		certs[i] = cert
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         r.CAPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := certs[0].Verify(opts)
+	return err
+}
+
+// verifyServerConnection verifies server certificates with the current CA pool,
+// it's used instead of tls.Config.RootCAs which can't be reloaded.
+// serverName is used if the connection has no server name, e.g. server is addressed by IP.
+func (r *CertReloader) verifyServerConnection(cs tls.ConnectionState, serverName string) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("no server certificate")
+	}
+	if cs.ServerName != "" {
+		serverName = cs.ServerName
+	}
+	if serverName == "" {
+		return ErrServerNameRequired
+	}
+
+	opts := x509.VerifyOptions{
+		Roots:         r.CAPool(),
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// NewServerTLSConfig returns server TLS config that reloads certificates on file change.
+// Client certificates are verified with CAFile if it's set, and required if RequireClientCert.
+func NewServerTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	if opts.RequireClientCert && opts.CAFile == "" {
+		return nil, ErrClientCARequired
+	}
+
+	r, err := NewCertReloader(opts.CertFile, opts.KeyFile, opts.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+	}
+
+	if opts.CAFile != "" {
+		cfg.ClientAuth = tls.RequestClientCert
+		if opts.RequireClientCert {
+			cfg.ClientAuth = tls.RequireAnyClientCert
+		}
+		cfg.VerifyPeerCertificate = r.verifyClientCertificate
+	}
+
+	return cfg, nil
+}
+
+// NewClientTLSConfig returns client TLS config, client certificate is sent if CertFile is set.
+// Server certificate is verified with CAFile or system CAs. Client certificate and CAFile are reloaded
+// on file change. With CAFile, ServerName must be set if server is addressed by IP.
+func NewClientTLSConfig(opts TLSOptions) (*tls.Config, error) {
+	r, err := NewCertReloader(opts.CertFile, opts.KeyFile, opts.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		MinVersion:           tls.VersionTLS12,
+		ServerName:           opts.ServerName,
+		GetClientCertificate: r.GetClientCertificate,
+	}
+
+	if opts.CAFile != "" {
+		// 默认验证使用固定的RootCAs，改为在VerifyConnection中用最新的CA验证
+		cfg.InsecureSkipVerify = true
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			return r.verifyServerConnection(cs, opts.ServerName)
+		}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,216 @@
+package keyservice
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert, usage x509.ExtKeyUsage) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{cn},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+		tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) write(t *testing.T, certFile, keyFile string) {
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}), 0600))
+	if keyFile != "" {
+		der, err := x509.MarshalECPrivateKey(c.key)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600))
+	}
+}
+
+// handshake returns common name of server certificate, and common name of client certificate seen by server
+func handshake(serverConfig, clientConfig *tls.Config) (serverCN, clientCN string, err error) {
+	ln, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		return
+	}
+	defer ln.Close()
+
+	done := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			done <- ""
+			return
+		}
+		defer conn.Close()
+		tlsConn := conn.(*tls.Conn)
+		if tlsConn.Handshake() != nil {
+			done <- ""
+			return
+		}
+		state := tlsConn.ConnectionState()
+		if len(state.PeerCertificates) > 0 {
+			done <- state.PeerCertificates[0].Subject.CommonName
+		} else {
+			done <- ""
+		}
+	}()
+
+	conn, err := tls.Dial("tcp", ln.Addr().String(), clientConfig)
+	if err != nil {
+		<-done
+		return
+	}
+	defer conn.Close()
+	// 服务端在TLS 1.3中验证客户端证书后才能确认握手结果
+	conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	if _, err = conn.Read(make([]byte, 1)); err != nil {
+		if ne, ok := err.(net.Error); (ok && ne.Timeout()) || err == io.EOF {
+			err = nil
+		} else {
+			<-done
+			return
+		}
+	}
+
+	serverCN = conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+	clientCN = <-done
+	return
+}
+
+func TestTLSConfig(t *testing.T) {
+	originInterval := certCheckInterval
+	certCheckInterval = 0
+	defer func() {
+		certCheckInterval = originInterval
+	}()
+
+	dir, err := ioutil.TempDir("", "ks-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+
+	ca := newTestCert(t, "test-ca", nil, 0)
+	ca.write(t, file("ca.pem"), "")
+	newTestCert(t, "localhost", ca, x509.ExtKeyUsageServerAuth).write(t, file("server.pem"), file("server.key"))
+	newTestCert(t, "order-service", ca, x509.ExtKeyUsageClientAuth).write(t, file("client.pem"), file("client.key"))
+	otherCA := newTestCert(t, "other-ca", nil, 0)
+	newTestCert(t, "evil-service", otherCA, x509.ExtKeyUsageClientAuth).write(t, file("evil.pem"), file("evil.key"))
+
+	_, err = NewServerTLSConfig(TLSOptions{CertFile: file("server.pem"), KeyFile: file("server.key"), RequireClientCert: true})
+	assert.Equal(t, ErrClientCARequired, err)
+
+	serverConfig, err := NewServerTLSConfig(TLSOptions{
+		CertFile:          file("server.pem"),
+		KeyFile:           file("server.key"),
+		CAFile:            file("ca.pem"),
+		RequireClientCert: true,
+	})
+	require.NoError(t, err)
+
+	clientConfig, err := NewClientTLSConfig(TLSOptions{
+		CertFile:   file("client.pem"),
+		KeyFile:    file("client.key"),
+		CAFile:     file("ca.pem"),
+		ServerName: "localhost",
+	})
+	require.NoError(t, err)
+
+	serverCN, clientCN, err := handshake(serverConfig, clientConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", serverCN)
+	assert.Equal(t, "order-service", clientCN)
+
+	// client certificate required
+	noCertConfig, err := NewClientTLSConfig(TLSOptions{CAFile: file("ca.pem"), ServerName: "localhost"})
+	require.NoError(t, err)
+	_, _, err = handshake(serverConfig, noCertConfig)
+	assert.Error(t, err)
+
+	// client certificate signed by other CA
+	evilConfig, err := NewClientTLSConfig(TLSOptions{
+		CertFile:   file("evil.pem"),
+		KeyFile:    file("evil.key"),
+		CAFile:     file("ca.pem"),
+		ServerName: "localhost",
+	})
+	require.NoError(t, err)
+	_, _, err = handshake(serverConfig, evilConfig)
+	assert.Error(t, err)
+
+	// hot reload server certificate
+	time.Sleep(10 * time.Millisecond)
+	newTestCert(t, "localhost-renewed", ca, x509.ExtKeyUsageServerAuth).write(t, file("server.pem"), file("server.key"))
+	clientConfig.ServerName = "localhost-renewed"
+	serverCN, _, err = handshake(serverConfig, clientConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost-renewed", serverCN)
+
+	// server name is verified
+	wrongNameConfig, err := NewClientTLSConfig(TLSOptions{CAFile: file("ca.pem"), ServerName: "other-host"})
+	require.NoError(t, err)
+	_, _, err = handshake(serverConfig, wrongNameConfig)
+	assert.Error(t, err)
+	// server addressed by IP without server name
+	noNameConfig, err := NewClientTLSConfig(TLSOptions{CertFile: file("client.pem"), KeyFile: file("client.key"), CAFile: file("ca.pem")})
+	require.NoError(t, err)
+	_, _, err = handshake(serverConfig, noNameConfig)
+	assert.Equal(t, ErrServerNameRequired, err)
+
+	// hot reload CA bundle, it's used by both server and clients
+	otherCA.write(t, file("ca.pem"), "")
+	// clients verify server with the new CA pool
+	_, _, err = handshake(serverConfig, evilConfig)
+	require.Error(t, err)
+	newTestCert(t, "localhost", otherCA, x509.ExtKeyUsageServerAuth).write(t, file("server.pem"), file("server.key"))
+	// server verifies clients with the new CA pool
+	serverCN, clientCN, err = handshake(serverConfig, evilConfig)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost", serverCN)
+	assert.Equal(t, "evil-service", clientCN)
+	// client certificate signed by the old CA is rejected
+	clientConfig.ServerName = "localhost"
+	_, _, err = handshake(serverConfig, clientConfig)
+	assert.Error(t, err)
+}
@@ -0,0 +1,145 @@
+package keyservice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidToken = errors.New("Invalid token")
+	ErrTokenExpired = errors.New("Token expired")
+)
+
+// 签名令牌的前缀，用于区分静态令牌
+const signedTokenPrefix = "ks1."
+
+// StaticToken is token configured in tokens file, only sha256 of token is stored
+type StaticToken struct {
+	Name   string `json:"name"`
+	SHA256 string `json:"sha256"` // hex encoded sha256 of token
+}
+
+type tokenClaims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// TokenAuthenticator authenticates API tokens, which are either static tokens from tokens file,
+// or HMAC-signed tokens with expiry issued by SignToken.
+type TokenAuthenticator struct {
+	static map[[sha256.Size]byte]string
+	secret []byte
+}
+
+// NewTokenAuthenticator returns *TokenAuthenticator, tokensFile is json file of []StaticToken, e.g.
+//
+//	[{"name": "report-job", "sha256": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"}]
+//
+// secret is the key to verify signed tokens. Either could be empty.
+func NewTokenAuthenticator(tokensFile string, secret string) (*TokenAuthenticator, error) {
+	a := &TokenAuthenticator{
+		static: make(map[[sha256.Size]byte]string),
+		secret: []byte(secret),
+	}
+
+	if tokensFile == "" {
+		return a, nil
+	}
+
+	contents, err := ioutil.ReadFile(tokensFile)
+	if err != nil {
+		return nil, err
+	}
+	var tokens []*StaticToken
+	if err = json.Unmarshal(contents, &tokens); err != nil {
+		return nil, err
+	}
+	for _, t := range tokens {
+		bs, err := hex.DecodeString(t.SHA256)
+		if err != nil || len(bs) != sha256.Size || t.Name == "" {
+			return nil, errors.New("invalid token of name=" + t.Name)
+		}
+		var sum [sha256.Size]byte
+		copy(sum[:], bs)
+		a.static[sum] = t.Name
+	}
+
+	return a, nil
+}
+
+// Authenticate returns name of token
+func (a *TokenAuthenticator) Authenticate(token string) (name string, err error) {
+	if strings.HasPrefix(token, signedTokenPrefix) {
+		return a.verify(token, time.Now())
+	}
+
+	// 按哈希查找，避免逐个比较令牌
+	if name, ok := a.static[sha256.Sum256([]byte(token))]; ok {
+		return name, nil
+	}
+
+	return "", ErrInvalidToken
+}
+
+func (a *TokenAuthenticator) verify(token string, now time.Time) (string, error) {
+	if len(a.secret) == 0 {
+		return "", ErrInvalidToken
+	}
+
+	i := strings.LastIndexByte(token, '.')
+	if i < len(signedTokenPrefix) {
+		return "", ErrInvalidToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(token[i+1:])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	if subtle.ConstantTimeCompare(sig, signToken(a.secret, token[:i])) != 1 {
+		return "", ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(token[len(signedTokenPrefix):i])
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+	var claims tokenClaims
+	if err = json.Unmarshal(payload, &claims); err != nil || claims.Subject == "" {
+		return "", ErrInvalidToken
+	}
+	if claims.ExpiresAt <= now.Unix() {
+		return "", ErrTokenExpired
+	}
+
+	return claims.Subject, nil
+}
+
+// SignToken issues token of name that expires at expiresAt, signed with secret.
+// Token format: ks1.base64url(claims json).base64url(HMAC-SHA256(secret, "ks1."+base64url(claims json)))
+func SignToken(secret string, name string, expiresAt time.Time) (string, error) {
+	if secret == "" || name == "" {
+		return "", ErrInvalidToken
+	}
+
+	payload, err := json.Marshal(&tokenClaims{Subject: name, ExpiresAt: expiresAt.Unix()})
+	if err != nil {
+		return "", err
+	}
+
+	token := signedTokenPrefix + base64.RawURLEncoding.EncodeToString(payload)
+
+	return token + "." + base64.RawURLEncoding.EncodeToString(signToken([]byte(secret), token)), nil
+}
+
+func signToken(secret []byte, data string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,71 @@
+package keyservice
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenAuthenticator(t *testing.T) {
+	file := filepath.Join(os.TempDir(), fmt.Sprintf("ks-tokens-%d", time.Now().UnixNano()+rand.Int63n(1000)))
+	defer os.Remove(file)
+
+	sum := sha256.Sum256([]byte("static-token-1"))
+	require.NoError(t, ioutil.WriteFile(file, []byte(fmt.Sprintf(`[{"name": "report-job", "sha256": "%s"}]`, hex.EncodeToString(sum[:]))), 0600))
+
+	a, err := NewTokenAuthenticator(file, "token-secret")
+	require.NoError(t, err)
+
+	name, err := a.Authenticate("static-token-1")
+	require.NoError(t, err)
+	assert.Equal(t, "report-job", name)
+
+	_, err = a.Authenticate("static-token-2")
+	assert.Equal(t, ErrInvalidToken, err)
+	_, err = a.Authenticate("")
+	assert.Equal(t, ErrInvalidToken, err)
+
+	token, err := SignToken("token-secret", "batch-job", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	name, err = a.Authenticate(token)
+	require.NoError(t, err)
+	assert.Equal(t, "batch-job", name)
+
+	token, err = SignToken("token-secret", "batch-job", time.Now().Add(-time.Second))
+	require.NoError(t, err)
+	_, err = a.Authenticate(token)
+	assert.Equal(t, ErrTokenExpired, err)
+
+	token, err = SignToken("other-secret", "batch-job", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = a.Authenticate(token)
+	assert.Equal(t, ErrInvalidToken, err)
+
+	// tampered claims
+	token, err = SignToken("token-secret", "batch-job", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	forged, _ := SignToken("token-secret", "admin", time.Now().Add(time.Hour))
+	_, err = a.Authenticate(forged[:len(signedTokenPrefix)] + forged[len(signedTokenPrefix):len(forged)-44] + token[len(token)-44:])
+	assert.Equal(t, ErrInvalidToken, err)
+	_, err = a.Authenticate("ks1.")
+	assert.Equal(t, ErrInvalidToken, err)
+
+	// signed tokens disabled without secret
+	a, err = NewTokenAuthenticator("", "")
+	require.NoError(t, err)
+	_, err = a.Authenticate(token)
+	assert.Equal(t, ErrInvalidToken, err)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(`[{"name": "report-job", "sha256": "invalid"}]`), 0600))
+	_, err = NewTokenAuthenticator(file, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,92 @@
+package keyservice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+)
+
+var ErrInvalidTokenizeValue = errors.New("Tokenize value must be 6-128 digits")
+
+// Tokenize encrypts digit string(e.g. phone number, bank card number) into a token of the same length
+// and alphabet with FF1 format-preserving encryption, so it fits fixed-width numeric columns.
+// The token carries no key version, the returned version must be passed to Detokenize after key rotation.
+// Tokens are deterministic and not authenticated, prefer Encrypt unless the format must be preserved.
+func (sv *KeyService) Tokenize(value string, keyID string) (token string, version uint16, err error) {
+	key, err := sv.LookupKey(keyID)
+
+	if err != nil {
+		return
+	}
+
+	if !key.IsActive() {
+		err = ErrKeyDisabled
+		return
+	}
+
+	token, err = sv.tokenize(value, keyID, key.Current().Value, false)
+	if err != nil {
+		return
+	}
+	version = key.Version
+
+	return
+}
+
+// Detokenize returns value of token generated by Tokenize with the specified key version, 0 for the current version
+func (sv *KeyService) Detokenize(token string, keyID string, version uint16) (string, error) {
+	key, err := sv.LookupKey(keyID)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !key.Enabled() {
+		return "", ErrKeyDisabled
+	}
+
+	if version == 0 {
+		version = key.Version
+	}
+	keyValue := key.valueOf(version)
+	if keyValue == "" {
+		return "", ErrInvalidEncryptedData
+	}
+
+	return sv.tokenize(token, keyID, keyValue, true)
+}
+
+func (sv *KeyService) tokenize(value string, keyID string, keyValue string, decrypt bool) (string, error) {
+	digits := make([]byte, len(value))
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return "", ErrInvalidTokenizeValue
+		}
+		digits[i] = value[i] - '0'
+	}
+
+	// 使用独立的令牌化密钥，与加密密钥隔离
+	mac := hmac.New(sha256.New, sv.deriveKey(keyValue))
+	mac.Write([]byte("tokenize"))
+
+	f, err := newFF1(mac.Sum(nil))
+	if err != nil {
+		return "", err
+	}
+
+	// key id as tweak
+	if decrypt {
+		digits, err = f.Decrypt(digits, []byte(keyID))
+	} else {
+		digits, err = f.Encrypt(digits, []byte(keyID))
+	}
+	if err != nil {
+		return "", ErrInvalidTokenizeValue
+	}
+
+	for i := range digits {
+		digits[i] += '0'
+	}
+
+	return string(digits), nil
+}
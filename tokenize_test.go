@@ -0,0 +1,55 @@
+package keyservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	sv := NewKeyService("seed-key", newTestStorage(), newTestCache())
+
+	for _, value := range []string{"13800138000", "6222020200112233445", "000000", "0123456789012345678901234567890123456789"} {
+		token, version, err := sv.Tokenize(value, _testKeyId2)
+		require.NoError(t, err)
+		assert.Equal(t, uint16(2), version)
+		assert.Equal(t, len(value), len(token))
+		assert.NotEqual(t, value, token)
+		for _, c := range token {
+			assert.True(t, c >= '0' && c <= '9')
+		}
+
+		token2, _, err := sv.Tokenize(value, _testKeyId2)
+		require.NoError(t, err)
+		assert.Equal(t, token, token2)
+
+		token2, _, _ = sv.Tokenize(value, _testKeyId1)
+		assert.NotEqual(t, token, token2)
+
+		detokenized, err := sv.Detokenize(token, _testKeyId2, version)
+		require.NoError(t, err)
+		assert.Equal(t, value, detokenized)
+		detokenized, err = sv.Detokenize(token, _testKeyId2, 0)
+		require.NoError(t, err)
+		assert.Equal(t, value, detokenized)
+	}
+
+	for _, value := range []string{"", "12345", "1380013800a", "１３８００１３８０００"} {
+		_, _, err := sv.Tokenize(value, _testKeyId2)
+		assert.Equal(t, ErrInvalidTokenizeValue, err, value)
+	}
+
+	token, version, err := sv.Tokenize("13800138000", _testKeyId2)
+	require.NoError(t, err)
+	_, err = sv.RotateKey(_testKeyId2)
+	require.NoError(t, err)
+	detokenized, err := sv.Detokenize(token, _testKeyId2, version)
+	require.NoError(t, err)
+	assert.Equal(t, "13800138000", detokenized)
+
+	_, err = sv.Detokenize(token, _testKeyId2, 10)
+	assert.Equal(t, ErrInvalidEncryptedData, err)
+	_, _, err = sv.Tokenize("13800138000", "key-not-exists-id")
+	assert.Equal(t, ErrNotFound, err)
+}